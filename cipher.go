@@ -0,0 +1,261 @@
+package textee
+
+import (
+	"sort"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// Cipher names one of the gematria systems a Textee scores its substrings with. The built-in ciphers score
+// letters alone as traditional gematria does, so "covid19" scores like "covid" while still being counted as
+// "covid19". Custom Ciphers receive the substring unchanged and may give digits a value of their own.
+type Cipher string
+
+const (
+	CipherEnglish  Cipher = "english"
+	CipherJewish   Cipher = "jewish"
+	CipherSimple   Cipher = "simple"
+	CipherMystery  Cipher = "mystery"
+	CipherMajestic Cipher = "majestic"
+	CipherEights   Cipher = "eights"
+)
+
+// Ciphers returns every built-in Cipher in the order String() reports them
+func Ciphers() []Cipher {
+	return []Cipher{CipherEnglish, CipherJewish, CipherSimple, CipherMystery, CipherMajestic, CipherEights}
+}
+
+// Value returns the score of gem under the cipher, unknown ciphers score 0
+func (c Cipher) Value(gem gematria.Gematria) uint64 {
+	switch c {
+	case CipherEnglish:
+		return gem.English
+	case CipherJewish:
+		return gem.Jewish
+	case CipherSimple:
+		return gem.Simple
+	case CipherMystery:
+		return gem.Mystery
+	case CipherMajestic:
+		return gem.Majestic
+	case CipherEights:
+		return gem.Eights
+	}
+	return 0
+}
+
+// builtin reports whether c is one of the ciphers returned by Ciphers
+func (c Cipher) builtin() bool {
+	for _, cipher := range Ciphers() {
+		if c == cipher {
+			return true
+		}
+	}
+	return false
+}
+
+// valueOf returns the score of substring under cipher, using Options.Ciphers for custom ciphers. Callers must
+// hold tt.mu.
+func (tt *Textee) valueOf(cipher Cipher, substring string) uint64 {
+	if !cipher.builtin() {
+		if value := tt.opts.Ciphers[string(cipher)]; value != nil {
+			return value(substring)
+		}
+		return 0
+	}
+	return cipher.Value(tt.Gematrias[substring])
+}
+
+// ScoresFor returns a copy of the buckets of cipher, built-in or custom, taken under the read lock so it is
+// safe to call while another goroutine parses or recalculates tt
+func (tt *Textee) ScoresFor(cipher Cipher) map[uint64][]string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	scores := cloneScores(tt.scores(cipher))
+	if scores == nil {
+		scores = make(map[uint64][]string)
+	}
+	return scores
+}
+
+// EnglishScores returns a copy of ScoresEnglish, see ScoresFor
+func (tt *Textee) EnglishScores() map[uint64][]string { return tt.ScoresFor(CipherEnglish) }
+
+// JewishScores returns a copy of ScoresJewish, see ScoresFor
+func (tt *Textee) JewishScores() map[uint64][]string { return tt.ScoresFor(CipherJewish) }
+
+// SimpleScores returns a copy of ScoresSimple, see ScoresFor
+func (tt *Textee) SimpleScores() map[uint64][]string { return tt.ScoresFor(CipherSimple) }
+
+// MysteryScores returns a copy of ScoresMystery, see ScoresFor
+func (tt *Textee) MysteryScores() map[uint64][]string { return tt.ScoresFor(CipherMystery) }
+
+// MajesticScores returns a copy of ScoresMajestic, see ScoresFor
+func (tt *Textee) MajesticScores() map[uint64][]string { return tt.ScoresFor(CipherMajestic) }
+
+// EightsScores returns a copy of ScoresEights, see ScoresFor
+func (tt *Textee) EightsScores() map[uint64][]string { return tt.ScoresFor(CipherEights) }
+
+// SubstringsByScore returns a sorted copy of the substrings scoring value under cipher, which may name a
+// built-in Cipher or one registered through Options.Ciphers
+func (tt *Textee) SubstringsByScore(cipher Cipher, value uint64) []string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return UnionScores(value, tt.scores(cipher)[value], nil)
+}
+
+// scores returns the Scores* map backing cipher, or its CustomScores entry for a custom cipher, callers must
+// hold tt.mu
+func (tt *Textee) scores(cipher Cipher) map[uint64][]string {
+	switch cipher {
+	case CipherEnglish:
+		return tt.ScoresEnglish
+	case CipherJewish:
+		return tt.ScoresJewish
+	case CipherSimple:
+		return tt.ScoresSimple
+	case CipherMystery:
+		return tt.ScoresMystery
+	case CipherMajestic:
+		return tt.ScoresMajestic
+	case CipherEights:
+		return tt.ScoresEights
+	}
+	return tt.CustomScores[string(cipher)]
+}
+
+// setScores replaces the Scores* map backing cipher, callers must hold tt.mu
+func (tt *Textee) setScores(cipher Cipher, scores map[uint64][]string) {
+	switch cipher {
+	case CipherEnglish:
+		tt.ScoresEnglish = scores
+	case CipherJewish:
+		tt.ScoresJewish = scores
+	case CipherSimple:
+		tt.ScoresSimple = scores
+	case CipherMystery:
+		tt.ScoresMystery = scores
+	case CipherMajestic:
+		tt.ScoresMajestic = scores
+	case CipherEights:
+		tt.ScoresEights = scores
+	}
+}
+
+// ClusterByScore groups the substrings whose cipher values sit within tolerance of their neighbour, linking
+// sorted values one after another so a cluster can span more than tolerance end to end. Clusters are ordered
+// by value and each cluster lists its substrings by value, then alphabetically.
+func (tt *Textee) ClusterByScore(cipher Cipher, tolerance uint64) [][]string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	scores := tt.scores(cipher)
+	values := make([]uint64, 0, len(scores))
+	for value, bucket := range scores {
+		if len(bucket) > 0 {
+			values = append(values, value)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	var clusters [][]string
+	var current []string
+	for i, value := range values {
+		if i > 0 && value-values[i-1] > tolerance {
+			clusters = append(clusters, current)
+			current = nil
+		}
+		bucket := append([]string(nil), scores[value]...)
+		sort.Strings(bucket)
+		current = append(current, bucket...)
+	}
+	if len(current) > 0 {
+		clusters = append(clusters, current)
+	}
+	return clusters
+}
+
+// Clusters returns the cipher buckets holding at least minSize distinct substrings, the phrases that add up
+// to the same value. Each bucket is a sorted copy so callers may modify it freely.
+func (tt *Textee) Clusters(cipher Cipher, minSize int) map[uint64][]string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	clusters := make(map[uint64][]string)
+	for value, bucket := range tt.scores(cipher) {
+		distinct := UnionScores(value, bucket, nil)
+		if len(distinct) < minSize || len(distinct) == 0 {
+			continue
+		}
+		clusters[value] = distinct
+	}
+	return clusters
+}
+
+// FindScore returns every substring that scores value under any cipher, alongside the ciphers that matched
+func (tt *Textee) FindScore(value uint64) map[string][]Cipher {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	matches := make(map[string][]Cipher)
+	for substring, gem := range tt.Gematrias {
+		for _, cipher := range Ciphers() {
+			if cipher.Value(gem) == value {
+				matches[substring] = append(matches[substring], cipher)
+			}
+		}
+	}
+	return matches
+}
+
+// MatchScore returns the substrings scoring exactly value under cipher with their quantities, sorted like
+// SortedSubstrings, or an empty result when no substring has that value
+func (tt *Textee) MatchScore(cipher Cipher, value uint64) SortedStringQuantities {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	matches := SortedStringQuantities{}
+	for _, substring := range UnionScores(value, tt.scores(cipher)[value], nil) {
+		if quantity, ok := tt.Substrings[substring]; ok {
+			matches = append(matches, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+		}
+	}
+	sort.Sort(matches)
+	return matches
+}
+
+// SortedByScore returns every substring with its quantity ordered by its value under cipher, ascending or
+// descending, with the substring text breaking ties. Substrings without a gematria entry score 0 and so
+// gather at one end of the list.
+func (tt *Textee) SortedByScore(cipher Cipher, ascending bool) []SubstringQuantity {
+	tt.mu.RLock()
+	sorted := make([]SubstringQuantity, 0, len(tt.Substrings))
+	values := make(map[string]uint64, len(tt.Substrings))
+	for substring, quantity := range tt.Substrings {
+		sorted = append(sorted, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+		values[substring] = tt.valueOf(cipher, substring)
+	}
+	tt.mu.RUnlock()
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := values[sorted[i].Substring], values[sorted[j].Substring]
+		if a != b {
+			return a < b == ascending
+		}
+		return sorted[i].Substring < sorted[j].Substring
+	})
+	return sorted
+}
+
+// Collision is a gematria value shared by two or more different substrings
+type Collision struct {
+	Value      uint64   `json:"v"`
+	Substrings []string `json:"s"`
+}
+
+// Collisions reports every value of cipher shared by at least two distinct substrings, ordered by value with
+// each Substrings slice sorted
+func (tt *Textee) Collisions(cipher Cipher) []Collision {
+	clusters := tt.Clusters(cipher, 2)
+	collisions := make([]Collision, 0, len(clusters))
+	for value, substrings := range clusters {
+		collisions = append(collisions, Collision{Value: value, Substrings: substrings})
+	}
+	sort.Slice(collisions, func(i, j int) bool { return collisions[i].Value < collisions[j].Value })
+	return collisions
+}
@@ -0,0 +1,217 @@
+package textee
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTextee_ClusterByScore(t *testing.T) {
+	// simple gematria: j = 10, l = 12, yy = 50
+	tt, err := NewTextee("J. L. YY.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.ClusterByScore(CipherSimple, 3)
+	want := [][]string{{"j", "l"}, {"yy"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ClusterByScore() = %v, want %v", got, want)
+	}
+}
+
+func TestTextee_Clusters(t *testing.T) {
+	// english gematria: abc = cab = 36 while "cab dog" stands alone
+	tt, err := NewTextee("cab dog. abc.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.Clusters(CipherEnglish, 2)
+	want := map[uint64][]string{36: {"abc", "cab"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Clusters() = %v, want %v", got, want)
+	}
+	got[36][0] = "mutated"
+	if tt.ScoresEnglish[36][0] == "mutated" {
+		t.Error("Clusters() returned a slice sharing the Scores* backing array")
+	}
+}
+
+func TestTextee_FindScore(t *testing.T) {
+	tt, err := NewTextee("manifesting three six nine")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.FindScore(337)
+	want := map[string][]Cipher{"manifesting": {CipherJewish}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindScore(337) = %v, want %v", got, want)
+	}
+	if got := tt.FindScore(1); got == nil || len(got) != 0 {
+		t.Errorf("FindScore(1) = %#v, want an empty map", got)
+	}
+}
+
+func TestTextee_SortedByScore(t *testing.T) {
+	// simple gematria: j = 10, l = 12, yy = 50, ab = ba = 3
+	tt, err := NewTextee("J. L. YY. BA. AB.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	tt.Substrings["unscored"] = tt.CounterFor("j")
+	order := func(sorted []SubstringQuantity) []string {
+		var out []string
+		for _, sq := range sorted {
+			out = append(out, sq.Substring)
+		}
+		return out
+	}
+	if got, want := order(tt.SortedByScore(CipherSimple, true)), []string{"unscored", "ab", "ba", "j", "l", "yy"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedByScore(ascending) = %v, want %v", got, want)
+	}
+	if got, want := order(tt.SortedByScore(CipherSimple, false)), []string{"yy", "l", "j", "ab", "ba", "unscored"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedByScore(descending) = %v, want %v", got, want)
+	}
+}
+
+func TestTextee_Collisions(t *testing.T) {
+	// simple gematria: ab = ba = 3, bad = dab = 7
+	tt, err := NewTextee("DAB. AB. BAD. BA.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := []Collision{
+		{Value: 3, Substrings: []string{"ab", "ba"}},
+		{Value: 7, Substrings: []string{"bad", "dab"}},
+	}
+	if got := tt.Collisions(CipherSimple); !reflect.DeepEqual(got, want) {
+		t.Errorf("Collisions() = %v, want %v", got, want)
+	}
+}
+
+func TestTextee_MatchScore(t *testing.T) {
+	tt, err := NewTextee("abc cba. abc dog.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	value := tt.Gematrias["abc"].Jewish
+	got := tt.MatchScore(CipherJewish, value)
+	want := SortedStringQuantities{{"abc", 2}, {"cba", 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatchScore() = %v, want %v", got, want)
+	}
+	if got := tt.MatchScore(CipherJewish, 1); got == nil || len(got) != 0 {
+		t.Errorf("MatchScore() without a bucket = %v, want an empty result", got)
+	}
+}
+
+func TestOptions_Ciphers(t *testing.T) {
+	letters := func(s string) uint64 { return uint64(len(strings.ReplaceAll(s, " ", ""))) }
+	opts := Options{Ciphers: map[string]func(string) uint64{
+		"letters":            letters,
+		string(CipherSimple): func(string) uint64 { return 1 },
+	}}
+	tt, err := NewTexteeWithOptions(opts, "red fish. big dog.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got, want := tt.SubstringsByScore("letters", 3), []string{"big", "dog", "red"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SubstringsByScore(letters, 3) = %v, want %v", got, want)
+	}
+	if got := tt.CustomScores["letters"][7]; !reflect.DeepEqual(got, []string{"red fish"}) {
+		t.Errorf("CustomScores[letters][7] = %v, want [red fish]", got)
+	}
+	if _, ok := tt.CustomScores[string(CipherSimple)]; ok {
+		t.Error("Options.Ciphers should not replace a built-in cipher")
+	}
+	if got := tt.SubstringsByScore(CipherSimple, tt.Gematrias["dog"].Simple); len(got) == 0 {
+		t.Error("SubstringsByScore() should still read the built-in Simple scores")
+	}
+	if sorted := tt.SortedByScore("letters", false); sorted[0].Substring != "red fish" {
+		t.Errorf("SortedByScore(letters)[0] = %v, want red fish", sorted[0])
+	}
+
+	tt.Substrings["cat"] = new(atomic.Int32)
+	tt.Substrings["cat"].Store(1)
+	if _, err := tt.CalculateGematriaFor([]string{"cat"}); err != nil {
+		t.Fatalf("CalculateGematriaFor() error = %v", err)
+	}
+	if got := tt.SubstringsByScore("letters", 3); !reflect.DeepEqual(got, []string{"big", "cat", "dog", "red"}) {
+		t.Errorf("SubstringsByScore(letters, 3) after CalculateGematriaFor = %v", got)
+	}
+}
+
+func TestCipher_Digits(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{Ciphers: map[string]func(string) uint64{
+		"digits": func(s string) uint64 { return uint64(strings.Count(s, "1") + strings.Count(s, "9")) },
+	}}, "Covid19 and covid spread.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if tt.Count("covid19") != 1 {
+		t.Errorf("Count(covid19) = %d, want the full token counted", tt.Count("covid19"))
+	}
+	if !sameGematria(tt.Gematrias["covid19"], tt.Gematrias["covid"]) {
+		t.Errorf("Gematrias[covid19] = %+v, want the letters-only score %+v", tt.Gematrias["covid19"], tt.Gematrias["covid"])
+	}
+	bucket := tt.ScoresEnglish[tt.Gematrias["covid"].English]
+	if !slices.Contains(bucket, "covid19") || !slices.Contains(bucket, "covid") {
+		t.Errorf("ScoresEnglish bucket = %v, want covid and covid19 together", bucket)
+	}
+	if got := tt.CustomScores["digits"][2]; !slices.Contains(got, "covid19") {
+		t.Errorf("CustomScores[digits][2] = %v, want covid19 scored with its digits", got)
+	}
+}
+
+func TestTextee_ScoresAccessorsConcurrent(t *testing.T) {
+	tt, err := NewTextee("abc cba. the dog ran. the cat sat.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := tt.EnglishScores()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			if _, err := tt.ParseString(tt.Input); err != nil {
+				t.Errorf("ParseString() error = %v", err)
+				return
+			}
+			if _, err := tt.CalculateGematria(); err != nil {
+				t.Errorf("CalculateGematria() error = %v", err)
+				return
+			}
+		}
+	}()
+	accessors := []func() map[uint64][]string{tt.EnglishScores, tt.JewishScores, tt.SimpleScores,
+		tt.MysteryScores, tt.MajesticScores, tt.EightsScores}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				for _, accessor := range accessors {
+					for _, bucket := range accessor() {
+						_ = len(bucket)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	got := tt.EnglishScores()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EnglishScores() = %v, want %v", got, want)
+	}
+	for value := range got {
+		got[value] = nil
+	}
+	if reflect.DeepEqual(tt.EnglishScores(), got) {
+		t.Error("EnglishScores() should return a copy")
+	}
+}
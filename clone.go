@@ -0,0 +1,60 @@
+package textee
+
+import (
+	"maps"
+	"sync/atomic"
+)
+
+// Clone returns a deep copy of tt with its own counters, maps, bucket slices and zero-valued mutex, so it can
+// be handed to another goroutine without later changes to either side leaking into the other
+func (tt *Textee) Clone() *Textee {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	clone := &Textee{
+		Input:          tt.Input,
+		Gematria:       tt.Gematria,
+		Substrings:     make(map[string]*atomic.Int32, len(tt.Substrings)),
+		Gematrias:      maps.Clone(tt.Gematrias),
+		ScoresEnglish:  cloneScores(tt.ScoresEnglish),
+		ScoresJewish:   cloneScores(tt.ScoresJewish),
+		ScoresSimple:   cloneScores(tt.ScoresSimple),
+		ScoresMystery:  cloneScores(tt.ScoresMystery),
+		ScoresMajestic: cloneScores(tt.ScoresMajestic),
+		ScoresEights:   cloneScores(tt.ScoresEights),
+		sentences:      append([]string(nil), tt.sentences...),
+		failed:         append([]FailedSubstring(nil), tt.failed...),
+		cleanFailures:  append([]string(nil), tt.cleanFailures...),
+		evictions:      tt.evictions,
+		opts:           tt.opts,
+		redactor:       tt.redactor,
+	}
+	if tt.CustomScores != nil {
+		clone.CustomScores = make(map[string]map[uint64][]string, len(tt.CustomScores))
+		for name, scores := range tt.CustomScores {
+			clone.CustomScores[name] = cloneScores(scores)
+		}
+	}
+	if tt.surfaces != nil {
+		clone.surfaces = make(map[string]map[string]int, len(tt.surfaces))
+		for substring, forms := range tt.surfaces {
+			clone.surfaces[substring] = maps.Clone(forms)
+		}
+	}
+	for substring, quantity := range tt.Substrings {
+		clone.Substrings[substring] = new(atomic.Int32)
+		clone.Substrings[substring].Store(quantity.Load())
+	}
+	return clone
+}
+
+// cloneScores copies scores along with every bucket slice so no backing array is shared
+func cloneScores(scores map[uint64][]string) map[uint64][]string {
+	if scores == nil {
+		return nil
+	}
+	clone := make(map[uint64][]string, len(scores))
+	for value, bucket := range scores {
+		clone[value] = append([]string(nil), bucket...)
+	}
+	return clone
+}
@@ -0,0 +1,44 @@
+package textee
+
+import "testing"
+
+func TestTextee_Clone(t *testing.T) {
+	tt, err := NewTextee("abc cab. abc.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	clone := tt.Clone()
+
+	clone.Substrings["abc"].Add(10)
+	clone.ScoresEnglish[36][0] = "changed"
+	delete(clone.Gematrias, "cab")
+	if got := tt.Substrings["abc"].Load(); got != 2 {
+		t.Errorf("original Substrings[abc] = %d after mutating the clone, want 2", got)
+	}
+	for _, substring := range tt.ScoresEnglish[36] {
+		if substring == "changed" {
+			t.Error("original ScoresEnglish shares a bucket with the clone")
+		}
+	}
+	if _, ok := tt.Gematrias["cab"]; !ok {
+		t.Error("original Gematrias lost cab after deleting it from the clone")
+	}
+
+	if _, err := tt.ParseString("something else entirely."); err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+	if got := clone.Substrings["abc"].Load(); got != 12 {
+		t.Errorf("clone Substrings[abc] = %d after reparsing the original, want 12", got)
+	}
+
+	capped, err := NewTexteeWithOptions(Options{MaxSubstrings: 2}, "one two three four.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if capped.Evictions() == 0 {
+		t.Fatal("Evictions() = 0, want MaxSubstrings to have evicted substrings")
+	}
+	if got, want := capped.Clone().Evictions(), capped.Evictions(); got != want {
+		t.Errorf("clone Evictions() = %d, want %d", got, want)
+	}
+}
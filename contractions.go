@@ -0,0 +1,48 @@
+package textee
+
+import (
+	"maps"
+	"regexp"
+	"strings"
+)
+
+var regContraction = regexp.MustCompile(`\b[A-Za-z]+['’][A-Za-z]+\b`)
+
+// contractions maps common English contractions to their expanded words
+var contractions = map[string]string{
+	"aren't": "are not", "can't": "cannot", "couldn't": "could not", "didn't": "did not",
+	"doesn't": "does not", "don't": "do not", "hadn't": "had not", "hasn't": "has not",
+	"haven't": "have not", "he'd": "he would", "he'll": "he will", "he's": "he is",
+	"i'd": "i would", "i'll": "i will", "i'm": "i am", "i've": "i have",
+	"isn't": "is not", "it'll": "it will", "it's": "it is", "let's": "let us",
+	"mustn't": "must not", "she'd": "she would", "she'll": "she will", "she's": "she is",
+	"shouldn't": "should not", "that's": "that is", "there's": "there is", "they'd": "they would",
+	"they'll": "they will", "they're": "they are", "they've": "they have", "wasn't": "was not",
+	"we'd": "we would", "we'll": "we will", "we're": "we are", "we've": "we have",
+	"weren't": "were not", "what's": "what is", "where's": "where is", "who's": "who is",
+	"won't": "will not", "wouldn't": "would not", "you'd": "you would", "you'll": "you will",
+	"you're": "you are", "you've": "you have",
+}
+
+// DefaultContractions returns a copy of the contractions ExpandContractions uses when none are configured
+func DefaultContractions() map[string]string {
+	return maps.Clone(contractions)
+}
+
+// expandContractions rewrites each contraction in input into its expansion, checking extra before the
+// defaults, and strips the 's from any other possessive so "teacher's" counts as "teacher"
+func expandContractions(input string, extra map[string]string) string {
+	return regContraction.ReplaceAllStringFunc(input, func(word string) string {
+		key := strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+		if expanded, ok := extra[key]; ok {
+			return expanded
+		}
+		if expanded, ok := contractions[key]; ok {
+			return expanded
+		}
+		if strings.HasSuffix(key, "'s") {
+			return word[:strings.LastIndexAny(word, "'’")]
+		}
+		return word
+	})
+}
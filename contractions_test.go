@@ -0,0 +1,33 @@
+package textee
+
+import "testing"
+
+func TestExpandContractions(t *testing.T) {
+	extra := map[string]string{"y'all": "you all"}
+	tests := map[string]string{
+		"Don't stop.":          "do not stop.",
+		"It’s the teacher's.":  "it is the teacher.",
+		"Y'all won't go":       "you all will not go",
+		"o'clock stays as is.": "o'clock stays as is.",
+	}
+	for input, want := range tests {
+		if got := expandContractions(input, extra); got != want {
+			t.Errorf("expandContractions(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestOptions_ExpandContractions(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{ExpandContractions: true}, "I don't know the teacher's name.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"do not know", "teacher", "the teacher name"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+	if _, ok := tt.Substrings["dont"]; ok {
+		t.Error("Substrings should not contain dont")
+	}
+}
@@ -0,0 +1,127 @@
+package textee
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultMaxAdjacencyWords bounds the vocabulary of AdjacencyMatrix when Options.MaxAdjacencyWords is 0
+const DefaultMaxAdjacencyWords = 2000
+
+// maxAdjacencyWords returns Options.MaxAdjacencyWords, or DefaultMaxAdjacencyWords when it is 0
+func (o Options) maxAdjacencyWords() int {
+	if o.MaxAdjacencyWords == 0 {
+		return DefaultMaxAdjacencyWords
+	}
+	return o.MaxAdjacencyWords
+}
+
+// AdjacencyMatrix returns the alphabetically ordered words of the sentences of tt alongside a symmetric matrix
+// where [i][j] counts the sentences containing both words[i] and words[j]. The diagonal is left at zero. The
+// words are taken from the sentences like CoOccurrence does, so they are there whatever Options.MinNgram is.
+// When the vocabulary exceeds Options.MaxAdjacencyWords only the words found in the most sentences are kept, so
+// memory stays near MaxAdjacencyWords² ints (about 32MB at the default) regardless of document size.
+func (tt *Textee) AdjacencyMatrix() ([]string, [][]int) {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+
+	sets := tt.distinctSentenceWords()
+	frequency := make(map[string]int)
+	for _, distinct := range sets {
+		for _, word := range distinct {
+			frequency[word]++
+		}
+	}
+	vocabulary := make(SortedStringQuantities, 0, len(frequency))
+	for word, sentences := range frequency {
+		vocabulary = append(vocabulary, SubstringQuantity{Substring: word, Quantity: sentences})
+	}
+	if limit := tt.opts.maxAdjacencyWords(); len(vocabulary) > limit {
+		sort.Slice(vocabulary, func(i, j int) bool {
+			if vocabulary[i].Quantity != vocabulary[j].Quantity {
+				return vocabulary[i].Quantity > vocabulary[j].Quantity
+			}
+			return vocabulary[i].Substring < vocabulary[j].Substring
+		})
+		vocabulary = vocabulary[:limit]
+	}
+
+	words := make([]string, len(vocabulary))
+	for i, sq := range vocabulary {
+		words[i] = sq.Substring
+	}
+	sort.Strings(words)
+	index := make(map[string]int, len(words))
+	for i, word := range words {
+		index[word] = i
+	}
+
+	matrix := make([][]int, len(words))
+	for i := range matrix {
+		matrix[i] = make([]int, len(words))
+	}
+	for _, distinct := range sets {
+		var present []int
+		for _, word := range distinct {
+			if i, ok := index[word]; ok {
+				present = append(present, i)
+			}
+		}
+		for a := 0; a < len(present); a++ {
+			for b := a + 1; b < len(present); b++ {
+				matrix[present[a]][present[b]]++
+				matrix[present[b]][present[a]]++
+			}
+		}
+	}
+	return words, matrix
+}
+
+// CoOccurrence counts, for every pair of distinct words, the sentences containing both. The result is
+// symmetric, [a][b] and [b][a] hold the same count, and keys are the cleaned word forms. Pairing costs
+// O(words²) per sentence, so Options.MaxPairWords can cap how many distinct words of a sentence are paired.
+func (tt *Textee) CoOccurrence() map[string]map[string]int {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	pairs := make(map[string]map[string]int)
+	for _, distinct := range tt.distinctSentenceWords() {
+		for a := 0; a < len(distinct); a++ {
+			for b := a + 1; b < len(distinct); b++ {
+				for _, pair := range [2][2]string{{distinct[a], distinct[b]}, {distinct[b], distinct[a]}} {
+					if pairs[pair[0]] == nil {
+						pairs[pair[0]] = make(map[string]int)
+					}
+					pairs[pair[0]][pair[1]]++
+				}
+			}
+		}
+	}
+	return pairs
+}
+
+// distinctSentenceWords returns the distinct words of each sentence in first-seen order, keeping at most
+// Options.MaxPairWords of them when it is set, callers must hold tt.mu
+func (tt *Textee) distinctSentenceWords() [][]string {
+	sets := make([][]string, 0, len(tt.sentences))
+	for _, sentence := range tt.sentences {
+		seen := make(map[string]struct{})
+		var distinct []string
+		for _, word := range tt.sentenceWords(sentence) {
+			if tt.opts.MaxPairWords > 0 && len(distinct) == tt.opts.MaxPairWords {
+				break
+			}
+			if _, dup := seen[word]; dup {
+				continue
+			}
+			seen[word] = struct{}{}
+			distinct = append(distinct, word)
+		}
+		sets = append(sets, distinct)
+	}
+	return sets
+}
+
+// isUnigram reports whether substring is a single word
+func isUnigram(substring string) bool {
+	return substring != "" && !strings.Contains(substring, " ")
+}
@@ -0,0 +1,77 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextee_AdjacencyMatrix(t *testing.T) {
+	tt, err := NewTextee("Cats chase mice. Dogs chase cats. Mice hide.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	words, matrix := tt.AdjacencyMatrix()
+	wantWords := []string{"cats", "chase", "dogs", "hide", "mice"}
+	if !reflect.DeepEqual(words, wantWords) {
+		t.Fatalf("AdjacencyMatrix() words = %v, want %v", words, wantWords)
+	}
+	want := [][]int{
+		//cats chase dogs hide mice
+		{0, 2, 1, 0, 1}, // cats
+		{2, 0, 1, 0, 1}, // chase
+		{1, 1, 0, 0, 0}, // dogs
+		{0, 0, 0, 0, 1}, // hide
+		{1, 1, 0, 1, 0}, // mice
+	}
+	if !reflect.DeepEqual(matrix, want) {
+		t.Errorf("AdjacencyMatrix() matrix = %v, want %v", matrix, want)
+	}
+	for i := range matrix {
+		for j := range matrix {
+			if matrix[i][j] != matrix[j][i] {
+				t.Errorf("AdjacencyMatrix() is not symmetric at [%d][%d]", i, j)
+			}
+		}
+	}
+
+	bigrams, err := NewTexteeWithOptions(Options{MinNgram: 2}, "Cats chase mice. Dogs chase cats. Mice hide.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got, gotMatrix := bigrams.AdjacencyMatrix(); !reflect.DeepEqual(got, wantWords) || !reflect.DeepEqual(gotMatrix, want) {
+		t.Errorf("AdjacencyMatrix() with MinNgram 2 = %v, %v, want the sentence words %v", got, gotMatrix, wantWords)
+	}
+
+	capped, err := NewTexteeWithOptions(Options{MaxAdjacencyWords: 2}, "Cats chase mice. Dogs chase cats. Mice hide.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got, gotMatrix := capped.AdjacencyMatrix(); !reflect.DeepEqual(got, []string{"cats", "chase"}) || len(gotMatrix) != 2 || gotMatrix[0][1] != 2 {
+		t.Errorf("AdjacencyMatrix() with MaxAdjacencyWords 2 = %v, %v, want cats and chase paired twice", got, gotMatrix)
+	}
+}
+
+func TestTextee_CoOccurrence(t *testing.T) {
+	tt, err := NewTextee("Cats chase mice. Dogs chase cats.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.CoOccurrence()
+	want := map[string]map[string]int{
+		"cats":  {"chase": 2, "mice": 1, "dogs": 1},
+		"chase": {"cats": 2, "mice": 1, "dogs": 1},
+		"mice":  {"cats": 1, "chase": 1},
+		"dogs":  {"chase": 1, "cats": 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CoOccurrence() = %v, want %v", got, want)
+	}
+
+	capped, err := NewTexteeWithOptions(Options{MaxPairWords: 2}, "Cats chase mice.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got, want := capped.CoOccurrence(), map[string]map[string]int{"cats": {"chase": 1}, "chase": {"cats": 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CoOccurrence() with MaxPairWords = %v, want %v", got, want)
+	}
+}
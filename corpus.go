@@ -0,0 +1,154 @@
+package textee
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// TFIDF scores every substring of each document in corpus by term frequency multiplied by inverse document
+// frequency. Term frequency is the substring count divided by the total count of substrings in that document
+// and document frequency is the number of documents containing the substring. The inverse document frequency
+// is smoothed as ln((1+N)/(1+df))+1 so a single-document corpus falls back to plain term frequency instead of
+// dividing by zero. The returned slice is in the same order as corpus and nil documents yield empty maps.
+func TFIDF(corpus []*Textee) []map[string]float64 {
+	counts := make([]map[string]int, len(corpus))
+	documentFrequency := make(map[string]int)
+	for i, tt := range corpus {
+		counts[i] = make(map[string]int)
+		if tt == nil {
+			continue
+		}
+		tt.mu.RLock()
+		for substring, quantity := range tt.Substrings {
+			if q := int(quantity.Load()); q > 0 {
+				counts[i][substring] = q
+				documentFrequency[substring]++
+			}
+		}
+		tt.mu.RUnlock()
+	}
+
+	n := float64(len(corpus))
+	scores := make([]map[string]float64, len(corpus))
+	for i, document := range counts {
+		scores[i] = make(map[string]float64, len(document))
+		total := 0
+		for _, q := range document {
+			total += q
+		}
+		if total == 0 {
+			continue
+		}
+		for substring, q := range document {
+			tf := float64(q) / float64(total)
+			idf := math.Log((1+n)/(1+float64(documentFrequency[substring]))) + 1
+			scores[i][substring] = tf * idf
+		}
+	}
+	return scores
+}
+
+// NewTexteeBatch builds one Textee per input concurrently, running at most runtime.NumCPU() at a time. The
+// results are in input order and partial success is kept: a failed input leaves nil at its index while its
+// error, prefixed with that index, is joined into the returned error alongside any other failures.
+func NewTexteeBatch(inputs []string) ([]*Textee, error) {
+	results := make([]*Textee, len(inputs))
+	errs := make([]error, len(inputs))
+	limit := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int, input string) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			tt, err := NewTextee(input)
+			if err != nil {
+				errs[i] = fmt.Errorf("input %d: %w", i, err)
+				return
+			}
+			results[i] = tt
+		}(i, input)
+	}
+	wg.Wait()
+	return results, errors.Join(errs...)
+}
+
+// Corpus is a read-only view over several documents that keeps each Textee separate, so results remain
+// attributable to the document they came from. A Corpus never modifies the documents it wraps.
+type Corpus struct {
+	documents []*Textee
+}
+
+// NewCorpus wraps documents in a Corpus, nil documents are kept in place and contribute nothing
+func NewCorpus(documents ...*Textee) *Corpus {
+	return &Corpus{documents: append([]*Textee(nil), documents...)}
+}
+
+// Documents returns the documents of the corpus in the order they were given
+func (c *Corpus) Documents() []*Textee {
+	return append([]*Textee(nil), c.documents...)
+}
+
+// DocumentFrequency returns how many documents contain substring, normalized by each document like its Count
+func (c *Corpus) DocumentFrequency(substring string) int {
+	frequency := 0
+	for _, tt := range c.documents {
+		if tt == nil {
+			continue
+		}
+		normalized, ok := tt.key(substring)
+		if !ok {
+			continue
+		}
+		tt.mu.RLock()
+		quantity, ok := tt.Substrings[normalized]
+		tt.mu.RUnlock()
+		if ok && quantity.Load() > 0 {
+			frequency++
+		}
+	}
+	return frequency
+}
+
+// GlobalCounts returns a fresh map of every substring with its count summed across all documents
+func (c *Corpus) GlobalCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, tt := range c.documents {
+		if tt == nil {
+			continue
+		}
+		tt.mu.RLock()
+		for substring, quantity := range tt.Substrings {
+			counts[substring] += int(quantity.Load())
+		}
+		tt.mu.RUnlock()
+	}
+	return counts
+}
+
+// TopGlobal returns the n substrings with the highest GlobalCounts sorted like SortedSubstrings, or all of
+// them when n is larger than the vocabulary. A non-positive n returns an empty result.
+func (c *Corpus) TopGlobal(n int) SortedStringQuantities {
+	top := SortedStringQuantities{}
+	if n <= 0 {
+		return top
+	}
+	for substring, quantity := range c.GlobalCounts() {
+		top = append(top, SubstringQuantity{Substring: substring, Quantity: quantity})
+	}
+	sort.Sort(top)
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+// TFIDF scores the documents of the corpus with TFIDF
+func (c *Corpus) TFIDF() []map[string]float64 {
+	return TFIDF(c.documents)
+}
@@ -0,0 +1,84 @@
+package textee
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestTFIDF(t *testing.T) {
+	t.Run("single document falls back to term frequency", func(t *testing.T) {
+		tt, err := NewTextee("red fish")
+		if err != nil {
+			t.Fatalf("NewTextee() error = %v", err)
+		}
+		scores := TFIDF([]*Textee{tt})
+		if len(scores) != 1 {
+			t.Fatalf("TFIDF() returned %d documents, want 1", len(scores))
+		}
+		// red, fish, red fish
+		if got, want := scores[0]["red"], 1.0/3.0; math.Abs(got-want) > 1e-9 {
+			t.Errorf("TFIDF()[0][red] = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("distinctive substrings outrank shared ones", func(t *testing.T) {
+		one, _ := NewTextee("red fish")
+		two, _ := NewTextee("blue fish")
+		scores := TFIDF([]*Textee{one, two, nil})
+		if len(scores[2]) != 0 {
+			t.Errorf("TFIDF() for nil document = %v, want empty", scores[2])
+		}
+		if scores[0]["red"] <= scores[0]["fish"] {
+			t.Errorf("TFIDF() red = %v should outrank fish = %v", scores[0]["red"], scores[0]["fish"])
+		}
+	})
+}
+
+func TestNewTexteeBatch(t *testing.T) {
+	inputs := []string{"first document.", "   ", "third document."}
+	results, err := NewTexteeBatch(inputs)
+	if !errors.Is(err, ErrEmptyInput) || !strings.Contains(err.Error(), "input 1") {
+		t.Errorf("NewTexteeBatch() error = %v, want input 1 to fail with %v", err, ErrEmptyInput)
+	}
+	if len(results) != len(inputs) {
+		t.Fatalf("NewTexteeBatch() returned %d results, want %d", len(results), len(inputs))
+	}
+	if results[1] != nil {
+		t.Error("NewTexteeBatch() result for the failed input should be nil")
+	}
+	if results[0] == nil || results[0].Substrings["first"] == nil || results[2] == nil || results[2].Substrings["third"] == nil {
+		t.Error("NewTexteeBatch() results are missing or out of order")
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	one, _ := NewTextee("red fish. red fish.")
+	two, _ := NewTextee("blue fish.")
+	corpus := NewCorpus(one, two, nil)
+
+	if got := corpus.DocumentFrequency("Fish!"); got != 2 {
+		t.Errorf("DocumentFrequency(fish) = %d, want 2", got)
+	}
+	if got := corpus.DocumentFrequency("red"); got != 1 {
+		t.Errorf("DocumentFrequency(red) = %d, want 1", got)
+	}
+	counts := corpus.GlobalCounts()
+	if counts["fish"] != 3 || counts["red fish"] != 2 || counts["blue"] != 1 {
+		t.Errorf("GlobalCounts() = %v", counts)
+	}
+	top := corpus.TopGlobal(2)
+	if len(top) != 2 || top[0] != (SubstringQuantity{"fish", 3}) || top[1] != (SubstringQuantity{"red", 2}) {
+		t.Errorf("TopGlobal(2) = %v, want fish then red", top)
+	}
+	if got := corpus.TopGlobal(0); got == nil || len(got) != 0 {
+		t.Errorf("TopGlobal(0) = %v, want an empty result", got)
+	}
+	if got := corpus.TopGlobal(100); len(got) != len(counts) {
+		t.Errorf("TopGlobal(100) returned %d substrings, want %d", len(got), len(counts))
+	}
+	if one.Substrings["fish"].Load() != 2 {
+		t.Error("Corpus should not modify its documents")
+	}
+}
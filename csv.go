@@ -0,0 +1,39 @@
+package textee
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes a header and then one row per substring to w, in SortedSubstrings order, holding the
+// substring, its quantity and its English, Jewish, Simple, Mystery, Majestic and Eights scores. Substrings
+// without a Gematrias entry leave the six score cells empty.
+func (tt *Textee) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	header := []string{"substring", "quantity"}
+	for _, cipher := range Ciphers() {
+		header = append(header, string(cipher))
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, data := range tt.sortedKeys() {
+		tt.mu.RLock()
+		gem, hasGematria := tt.Gematrias[data.Substring]
+		row := []string{tt.display(data.Substring), strconv.Itoa(data.Quantity)}
+		tt.mu.RUnlock()
+		for _, cipher := range Ciphers() {
+			cell := ""
+			if hasGematria {
+				cell = strconv.FormatUint(cipher.Value(gem), 10)
+			}
+			row = append(row, cell)
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,49 @@
+package textee
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTextee_WriteCSV(t *testing.T) {
+	tt, err := NewTextee("Red fish, blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var b strings.Builder
+	if err := tt.WriteCSV(&b); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	want := []string{"substring", "quantity", "english", "jewish", "simple", "mystery", "majestic", "eights"}
+	if strings.Join(rows[0], ",") != strings.Join(want, ",") {
+		t.Errorf("header = %v, want %v", rows[0], want)
+	}
+	sorted := tt.SortedSubstrings()
+	if len(rows)-1 != len(sorted) {
+		t.Fatalf("WriteCSV() wrote %d rows, want %d", len(rows)-1, len(sorted))
+	}
+	first := rows[1]
+	gem := tt.Gematrias[sorted[0].Substring]
+	if first[0] != sorted[0].Substring || first[1] != strconv.Itoa(sorted[0].Quantity) ||
+		first[2] != strconv.FormatUint(gem.English, 10) || first[7] != strconv.FormatUint(gem.Eights, 10) {
+		t.Errorf("first row = %v, want %v with its scores", first, sorted[0])
+	}
+
+	unscored, err := NewTexteeWithOptions(Options{SkipGematria: true}, "just words")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	b.Reset()
+	if err := unscored.WriteCSV(&b); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	if line := strings.Split(b.String(), "\n")[1]; line != "just,1,,,,,," {
+		t.Errorf("unscored row = %q, want empty score cells", line)
+	}
+}
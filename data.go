@@ -6,15 +6,22 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"unicode"
 
 	"github.com/andreimerlescu/gematria"
 )
 
 var (
-	ErrEmptyInput    ArgumentError = errors.New("empty input")
-	ErrGematriaParse GematriaError = errors.New("unable to parse gematria for value")
-	ErrRegexpMissing RegexpError   = errors.New("regexp compile result missing")
-	ErrBadParsing    ParseError    = errors.New("failed to parse the string")
+	ErrEmptyInput     ArgumentError = errors.New("empty input")
+	ErrInputTooLarge  ArgumentError = errors.New("input exceeds the maximum size")
+	ErrInvalidOptions ArgumentError = errors.New("invalid options")
+	ErrGematriaParse  GematriaError = errors.New("unable to parse gematria for value")
+	ErrRegexpMissing  RegexpError   = errors.New("regexp compile result missing")
+	ErrInvalidPattern RegexpError   = errors.New("invalid search pattern")
+	ErrBadParsing     ParseError    = errors.New("failed to parse the string")
+	ErrOpenFile       IOError       = errors.New("unable to open file")
+	ErrReadInput      IOError       = errors.New("unable to read input")
+	ErrWriteFile      IOError       = errors.New("unable to write file")
 )
 
 type ArgumentError error
@@ -22,19 +29,40 @@ type GematriaError error
 type RegexpError error
 type ParseError error
 type CleanError error
+type IOError error
 
+// Textee holds the substrings of a parsed input along with their gematria. The exported maps are written by
+// ParseString and CalculateGematria under an internal lock, so reading them directly is only safe once nothing
+// else is modifying tt. Use the accessors such as SortedSubstrings and EnglishScores, which lock and copy,
+// whenever another goroutine may be parsing or recalculating.
 type Textee struct {
 	mu             sync.RWMutex
-	Input          string                       `json:"in"`
-	Gematria       gematria.Gematria            `json:"gem"`
-	Substrings     map[string]*atomic.Int32     `json:"subs"` // map[Substring]*atomic.Int32
-	Gematrias      map[string]gematria.Gematria `json:"gems"`
-	ScoresEnglish  map[uint64][]string          `json:"sen"`
-	ScoresJewish   map[uint64][]string          `json:"sje"`
-	ScoresSimple   map[uint64][]string          `json:"ssi"`
-	ScoresMystery  map[uint64][]string          `json:"smy"`
-	ScoresMajestic map[uint64][]string          `json:"smj"`
-	ScoresEights   map[uint64][]string          `json:"sei"`
+	Input          string                         `json:"in"`
+	Gematria       gematria.Gematria              `json:"gem"`
+	Substrings     map[string]*atomic.Int32       `json:"subs"` // map[Substring]*atomic.Int32
+	Gematrias      map[string]gematria.Gematria   `json:"gems"`
+	ScoresEnglish  map[uint64][]string            `json:"sen"`
+	ScoresJewish   map[uint64][]string            `json:"sje"`
+	ScoresSimple   map[uint64][]string            `json:"ssi"`
+	ScoresMystery  map[uint64][]string            `json:"smy"`
+	ScoresMajestic map[uint64][]string            `json:"smj"`
+	ScoresEights   map[uint64][]string            `json:"sei"`
+	CustomScores   map[string]map[uint64][]string `json:"cus,omitempty"` // map[cipher name]scores
+	sentences      []string
+	failed         []FailedSubstring
+	cleanFailures  []string
+	surfaces       map[string]map[string]int
+	metrics        *Metrics
+	evictions      int
+	opts           Options
+	redactor       *regexp.Regexp // Options.Redact compiled by compileRedact
+}
+
+// FailedSubstring is a substring CalculateGematria could not score and left out of Gematrias and the Scores*
+// maps, it is still counted in Substrings
+type FailedSubstring struct {
+	Substring string `json:"s"`
+	Err       error  `json:"-"`
 }
 
 type SubstringQuantity struct {
@@ -45,22 +73,71 @@ type SubstringQuantity struct {
 type SortedStringQuantities []SubstringQuantity
 
 var regCleanSubstring = regexp.MustCompile(`[^a-zA-Z0-9\s]`)
-var regFindSentences = regexp.MustCompile(`(?m)([^.!?]*[.!?])(?:\s|$)`)
 
-// stringToSentenceSlice splits text into sentences, considering abbreviations.
+// foldASCII maps the letters locale specific lowercasing produces outside ASCII back to their ASCII form, so
+// cleaning keeps them: the Turkish and Azeri dotless "ı" becomes "i"
+var foldASCII = strings.NewReplacer("ı", "i")
+
+// regFindSentences ends a sentence at a terminator, along with any closing quotes, parentheses or brackets right
+// after it, that is followed by whitespace or the end of a line
+var regFindSentences = regexp.MustCompile(`(?m)([^.!?]*[.!?][)\]}"'”’»]*)(?:\s|$)`)
+
+// stringToSentenceSlice splits text into sentences, each running from the end of the previous one through its
+// terminator so text between or after terminators is never lost. Text without any terminator falls back to
+// one sentence per line, and sentences holding no letters or digits, such as a run of "!!!", are dropped.
 func stringToSentenceSlice(text string) ([]string, error) {
 	if regFindSentences == nil {
 		return nil, ErrRegexpMissing
 	}
-	matches := regFindSentences.FindAllString(text, -1)
-	for i, match := range matches {
-		matches[i] = strings.TrimSpace(match)
+	var sentences []string
+	start := 0
+	for _, loc := range regFindSentences.FindAllStringIndex(text, -1) {
+		sentences = appendSentence(sentences, text[start:loc[1]])
+		start = loc[1]
+	}
+	if start == 0 {
+		for _, line := range strings.Split(text, "\n") {
+			sentences = appendSentence(sentences, line)
+		}
+		return sentences, nil
 	}
+	return appendSentence(sentences, text[start:]), nil
+}
 
-	if len(matches) == 0 {
-		return []string{text}, nil
+// appendSentence appends sentence, trimmed, to sentences unless it holds no letters or digits
+func appendSentence(sentences []string, sentence string) []string {
+	sentence = strings.TrimSpace(sentence)
+	if strings.IndexFunc(sentence, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) < 0 {
+		return sentences
+	}
+	return append(sentences, sentence)
+}
+
+// splitSentences breaks text into sentences using the delimiters configured in tt.opts, never returning one
+// without any letters or digits
+func (tt *Textee) splitSentences(text string) ([]string, error) {
+	if tt.opts.SentenceDelimiter != nil {
+		var sentences []string
+		for _, sentence := range tt.opts.SentenceDelimiter.Split(text, -1) {
+			sentences = appendSentence(sentences, sentence)
+		}
+		return sentences, nil
+	}
+	if !tt.opts.NewlineSentences {
+		return stringToSentenceSlice(text)
 	}
-	return matches, nil
+	var sentences []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lineSentences, err := stringToSentenceSlice(line)
+		if err != nil {
+			return nil, err
+		}
+		sentences = append(sentences, lineSentences...)
+	}
+	return sentences, nil
 }
 
 // cleanSubstring returns the string to A-Za-z0-9\s only
@@ -71,3 +148,44 @@ func cleanSubstring(word string) (string, error) {
 	word = strings.TrimSpace(word)
 	return regCleanSubstring.ReplaceAllString(word, ""), nil
 }
+
+// sentenceWords returns the cleaned, lowercased words of sentence, skipping any that clean away to nothing
+func (tt *Textee) sentenceWords(sentence string) []string {
+	var words []string
+	for _, segment := range tt.segments(sentence) {
+		for _, token := range tt.tokens(segment) {
+			word, err := tt.normalize(token)
+			if err == nil && word != "" {
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// normalize lowercases s with Options.Casing when it is set and then normalizes it like normalizeSubstring,
+// only cleaning and trimming it under Options.CaseSensitive
+func (tt *Textee) normalize(s string) (string, error) {
+	if tt.opts.CaseSensitive {
+		cleaned, err := tt.opts.clean(s)
+		return strings.TrimSpace(cleaned), err
+	}
+	if tt.opts.Casing != nil {
+		s = foldASCII.Replace(strings.ToLowerSpecial(tt.opts.Casing, s))
+	}
+	return tt.opts.normalizeSubstring(s)
+}
+
+// isNumeric reports whether s holds at least one digit and nothing but digits and spaces
+func isNumeric(s string) bool {
+	hasDigit := false
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r != ' ':
+			return false
+		}
+	}
+	return hasDigit
+}
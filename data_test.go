@@ -0,0 +1,158 @@
+package textee
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestTextee_splitSentences(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  Options
+		input string
+		want  []string
+	}{
+		{
+			name:  "default terminators",
+			input: "One two. Three four!",
+			want:  []string{"One two.", "Three four!"},
+		},
+		{
+			name:  "terminator runs",
+			input: "Wow!!! Really?! ... Yes.",
+			want:  []string{"Wow!!!", "Really?!", "Yes."},
+		},
+		{
+			name:  "trailing text without a terminator",
+			input: "It costs 3.5 dollars. Read more",
+			want:  []string{"It costs 3.5 dollars.", "Read more"},
+		},
+		{
+			name:  "terminator inside quotes",
+			input: `She said "Go home." and left.`,
+			want:  []string{`She said "Go home."`, "and left."},
+		},
+		{
+			name:  "nested quotes",
+			input: `He wrote "she told me 'stop!'" Then he left.`,
+			want:  []string{`He wrote "she told me 'stop!'"`, "Then he left."},
+		},
+		{
+			name:  "trailing parenthetical",
+			input: "The curve rises. (See figure 1.) It then falls [again.]",
+			want:  []string{"The curve rises.", "(See figure 1.)", "It then falls [again.]"},
+		},
+		{
+			name:  "punctuation-free input",
+			input: "roses are red\nviolets are blue",
+			want:  []string{"roses are red", "violets are blue"},
+		},
+		{
+			name:  "bulleted list",
+			opts:  Options{NewlineSentences: true},
+			input: "Groceries:\n- milk\n- fresh eggs\n\n- bread",
+			want:  []string{"Groceries:", "- milk", "- fresh eggs", "- bread"},
+		},
+		{
+			name:  "multi-paragraph block",
+			opts:  Options{NewlineSentences: true},
+			input: "First paragraph. Still first.\n\nSecond paragraph",
+			want:  []string{"First paragraph.", "Still first.", "Second paragraph"},
+		},
+		{
+			name:  "custom delimiter",
+			opts:  Options{SentenceDelimiter: regexp.MustCompile(`\s*;\s*`)},
+			input: "alpha beta; gamma ;  ; - ; delta",
+			want:  []string{"alpha beta", "gamma", "delta"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := &Textee{opts: tc.opts}
+			got, err := tt.splitSentences(tc.input)
+			if err != nil {
+				t.Fatalf("splitSentences() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("splitSentences() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTextee_ParseStringWithoutTerminators(t *testing.T) {
+	tt, err := NewTextee("roses are red\nviolets are blue")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got := tt.Sentences(); len(got) != 2 {
+		t.Errorf("Sentences() = %q, want one per line", got)
+	}
+	if _, ok := tt.Substrings["red violets"]; ok {
+		t.Error("Substrings should not span lines of punctuation-free input")
+	}
+}
+
+func TestOptions_NewlineSentences(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{NewlineSentences: true}, "roses are red\nviolets are blue")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, ok := tt.Substrings["red violets"]; ok {
+		t.Error("Substrings should not span a line break with NewlineSentences")
+	}
+}
+
+func TestTextee_NgramsStayWithinSentences(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   Options
+		input  string
+		spans  []string
+		within []string
+	}{
+		{
+			name:   "back-to-back terminators",
+			input:  "red fish!!! ?? blue fish.. green fish",
+			spans:  []string{"fish blue", "fish green"},
+			within: []string{"red fish", "blue fish", "green fish"},
+		},
+		{
+			name:   "empty lines without terminators",
+			input:  "red fish\n\n\n\nblue fish",
+			spans:  []string{"fish blue"},
+			within: []string{"red fish", "blue fish"},
+		},
+		{
+			name:   "empty lines with newline sentences",
+			opts:   Options{NewlineSentences: true},
+			input:  "red fish\n \n.\nblue fish.",
+			spans:  []string{"fish blue"},
+			within: []string{"red fish", "blue fish"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt, err := NewTexteeWithOptions(tc.opts, tc.input)
+			if err != nil {
+				t.Fatalf("NewTexteeWithOptions() error = %v", err)
+			}
+			for _, sentence := range tt.Sentences() {
+				if sentence == "" {
+					t.Errorf("Sentences() = %q, want no empty sentences", tt.Sentences())
+				}
+			}
+			for _, substring := range tc.spans {
+				if _, ok := tt.Substrings[substring]; ok {
+					t.Errorf("Substrings[%q] spans two sentences", substring)
+				}
+			}
+			for _, substring := range tc.within {
+				if _, ok := tt.Substrings[substring]; !ok {
+					t.Errorf("Substrings[%q] missing", substring)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package textee
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// NewSince returns the substrings tt gained since snapshot, those absent from it reported with their full
+// count and those that grew reported with the increase. A nil snapshot returns every substring of tt.
+func (tt *Textee) NewSince(snapshot *Textee) SortedStringQuantities {
+	before := make(map[string]int)
+	if snapshot != nil {
+		snapshot.mu.RLock()
+		for substring, quantity := range snapshot.Substrings {
+			before[substring] = int(quantity.Load())
+		}
+		snapshot.mu.RUnlock()
+	}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	added := SortedStringQuantities{}
+	for substring, quantity := range tt.Substrings {
+		if delta := int(quantity.Load()) - before[substring]; delta > 0 {
+			added = append(added, SubstringQuantity{Substring: substring, Quantity: delta})
+		}
+	}
+	sort.Sort(added)
+	return added
+}
+
+// SubstringDelta is a substring counted on both sides of a Diff with a different quantity on each
+type SubstringDelta struct {
+	Substring string `json:"s"`
+	Before    int    `json:"b"`
+	After     int    `json:"a"`
+}
+
+// TexteeDiff lists how the substrings of one Textee changed into those of another, see Diff
+type TexteeDiff struct {
+	Added   SortedStringQuantities `json:"add"` // substrings only the other side has, with their counts
+	Removed SortedStringQuantities `json:"rm"`  // substrings only the receiver has, with their counts
+	Changed []SubstringDelta       `json:"chg"` // shared substrings whose counts differ
+}
+
+// Diff compares tt, the earlier version, with other, the later one. Added and Removed are sorted like
+// SortedSubstrings and Changed lists the largest changes first, then by substring. A nil other removes
+// everything, and the two sides are read one after the other so neither lock is held while taking the other.
+func (tt *Textee) Diff(other *Textee) TexteeDiff {
+	before, after := tt.counts(), other.counts()
+	diff := TexteeDiff{Added: SortedStringQuantities{}, Removed: SortedStringQuantities{}, Changed: []SubstringDelta{}}
+	for substring, quantity := range before {
+		switch now, ok := after[substring]; {
+		case !ok:
+			diff.Removed = append(diff.Removed, SubstringQuantity{Substring: substring, Quantity: quantity})
+		case now != quantity:
+			diff.Changed = append(diff.Changed, SubstringDelta{Substring: substring, Before: quantity, After: now})
+		}
+	}
+	for substring, quantity := range after {
+		if _, ok := before[substring]; !ok {
+			diff.Added = append(diff.Added, SubstringQuantity{Substring: substring, Quantity: quantity})
+		}
+	}
+	sort.Sort(diff.Added)
+	sort.Sort(diff.Removed)
+	slices.SortFunc(diff.Changed, func(a, b SubstringDelta) int {
+		if c := cmp.Compare(abs(b.After-b.Before), abs(a.After-a.Before)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Substring, b.Substring)
+	})
+	return diff
+}
+
+// counts copies the substring counts of tt into a plain map, a nil tt has none
+func (tt *Textee) counts() map[string]int {
+	out := make(map[string]int)
+	if tt == nil {
+		return out
+	}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	for substring, quantity := range tt.Substrings {
+		out[substring] = int(quantity.Load())
+	}
+	return out
+}
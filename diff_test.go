@@ -0,0 +1,63 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextee_NewSince(t *testing.T) {
+	snapshot, err := NewTextee("red fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	current, err := NewTextee("red fish. red boat.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := SortedStringQuantities{
+		{Substring: "boat", Quantity: 1},
+		{Substring: "red", Quantity: 1},
+		{Substring: "red boat", Quantity: 1},
+	}
+	if got := current.NewSince(snapshot); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewSince() = %v, want %v", got, want)
+	}
+	if got := current.NewSince(current); len(got) != 0 {
+		t.Errorf("NewSince(self) = %v, want none", got)
+	}
+}
+
+func TestTextee_Diff(t *testing.T) {
+	before, err := NewTextee("red fish. red fish. old boat.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	after, err := NewTextee("red fish. new boat.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	diff := before.Diff(after)
+	wantAdded := SortedStringQuantities{{Substring: "new", Quantity: 1}, {Substring: "new boat", Quantity: 1}}
+	if !reflect.DeepEqual(diff.Added, wantAdded) {
+		t.Errorf("Diff().Added = %v, want %v", diff.Added, wantAdded)
+	}
+	wantRemoved := SortedStringQuantities{{Substring: "old", Quantity: 1}, {Substring: "old boat", Quantity: 1}}
+	if !reflect.DeepEqual(diff.Removed, wantRemoved) {
+		t.Errorf("Diff().Removed = %v, want %v", diff.Removed, wantRemoved)
+	}
+	wantChanged := []SubstringDelta{
+		{Substring: "fish", Before: 2, After: 1},
+		{Substring: "red", Before: 2, After: 1},
+		{Substring: "red fish", Before: 2, After: 1},
+	}
+	if !reflect.DeepEqual(diff.Changed, wantChanged) {
+		t.Errorf("Diff().Changed = %v, want %v", diff.Changed, wantChanged)
+	}
+
+	if self := before.Diff(before); len(self.Added)+len(self.Removed)+len(self.Changed) != 0 {
+		t.Errorf("Diff(self) = %+v, want no changes", self)
+	}
+	if gone := before.Diff(nil); len(gone.Removed) != len(before.Substrings) {
+		t.Errorf("Diff(nil) removed %d substrings, want all %d", len(gone.Removed), len(before.Substrings))
+	}
+}
@@ -0,0 +1,194 @@
+package textee
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"sort"
+	"sync/atomic"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// texteeSnapshot is the serializable form of a Textee, holding the substring counters as plain ints
+type texteeSnapshot struct {
+	Input          string                         `json:"in"`
+	Gematria       gematria.Gematria              `json:"gem"`
+	Substrings     map[string]int                 `json:"subs"`
+	Gematrias      map[string]gematria.Gematria   `json:"gems"`
+	ScoresEnglish  map[uint64][]string            `json:"sen"`
+	ScoresJewish   map[uint64][]string            `json:"sje"`
+	ScoresSimple   map[uint64][]string            `json:"ssi"`
+	ScoresMystery  map[uint64][]string            `json:"smy"`
+	ScoresMajestic map[uint64][]string            `json:"smj"`
+	ScoresEights   map[uint64][]string            `json:"sei"`
+	CustomScores   map[string]map[uint64][]string `json:"cus,omitempty"`
+	Sentences      []string                       `json:"sents,omitempty"`
+}
+
+// snapshot copies the state of tt into a texteeSnapshot, callers must hold tt.mu
+func (tt *Textee) snapshot() texteeSnapshot {
+	substrings := make(map[string]int, len(tt.Substrings))
+	for substring, quantity := range tt.Substrings {
+		substrings[substring] = int(quantity.Load())
+	}
+	return texteeSnapshot{
+		Input:          tt.Input,
+		Gematria:       tt.Gematria,
+		Substrings:     substrings,
+		Gematrias:      tt.Gematrias,
+		ScoresEnglish:  tt.ScoresEnglish,
+		ScoresJewish:   tt.ScoresJewish,
+		ScoresSimple:   tt.ScoresSimple,
+		ScoresMystery:  tt.ScoresMystery,
+		ScoresMajestic: tt.ScoresMajestic,
+		ScoresEights:   tt.ScoresEights,
+		CustomScores:   tt.CustomScores,
+		Sentences:      tt.sentences,
+	}
+}
+
+// restore replaces the state of tt with snap, rebuilding the atomic counters and, when snap holds gematria but
+// no built-in Scores* buckets, the buckets too. Everything that is not serialized, Options included, is reset so
+// a reused receiver keeps nothing from its previous state. Callers must hold tt.mu.
+func (tt *Textee) restore(snap texteeSnapshot) {
+	tt.surfaces = nil
+	tt.failed = nil
+	tt.cleanFailures = nil
+	tt.evictions = 0
+	tt.metrics = nil
+	tt.opts = Options{}
+	tt.redactor = nil
+	tt.Input = snap.Input
+	tt.Gematria = snap.Gematria
+	tt.Substrings = make(map[string]*atomic.Int32, len(snap.Substrings))
+	for substring, quantity := range snap.Substrings {
+		tt.Substrings[substring] = new(atomic.Int32)
+		tt.Substrings[substring].Store(int32(quantity))
+	}
+	tt.Gematrias = orEmpty(snap.Gematrias)
+	tt.ScoresEnglish = orEmptyScores(snap.ScoresEnglish)
+	tt.ScoresJewish = orEmptyScores(snap.ScoresJewish)
+	tt.ScoresSimple = orEmptyScores(snap.ScoresSimple)
+	tt.ScoresMystery = orEmptyScores(snap.ScoresMystery)
+	tt.ScoresMajestic = orEmptyScores(snap.ScoresMajestic)
+	tt.ScoresEights = orEmptyScores(snap.ScoresEights)
+	tt.CustomScores = snap.CustomScores
+	tt.sentences = snap.Sentences
+	if len(tt.Gematrias) == 0 {
+		return
+	}
+	for _, cipher := range Ciphers() {
+		if len(tt.scores(cipher)) > 0 {
+			return
+		}
+	}
+	for _, cipher := range Ciphers() {
+		scores := make(map[uint64][]string)
+		for substring, gem := range tt.Gematrias {
+			insertScore(scores, cipher.Value(gem), substring)
+		}
+		tt.setScores(cipher, scores)
+	}
+}
+
+// GobEncode implements gob.GobEncoder, storing the substring counts as plain ints
+func (tt *Textee) GobEncode() ([]byte, error) {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(tt.snapshot()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, rebuilding the atomic counters from the encoded counts
+func (tt *Textee) GobDecode(data []byte) error {
+	var snap texteeSnapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&snap); err != nil {
+		return err
+	}
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.restore(snap)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing the substring counts as plain ints
+func (tt *Textee) MarshalJSON() ([]byte, error) {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return json.Marshal(tt.snapshot())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading what MarshalJSON writes back into a usable Textee with
+// fresh atomic counters. Options are not serialized, so the decoded Textee parses with the defaults even when
+// the receiver was built with other Options.
+func (tt *Textee) UnmarshalJSON(data []byte) error {
+	var snap texteeSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return err
+	}
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.restore(snap)
+	return nil
+}
+
+// MarshalJSONIndent is the diff-friendly form of MarshalJSON: indented, with every map written in key order
+// and every Scores* bucket sorted, so committed fixtures stay stable between runs
+func (tt *Textee) MarshalJSONIndent() ([]byte, error) {
+	tt.mu.RLock()
+	snap := tt.snapshot()
+	for _, scores := range []*map[uint64][]string{&snap.ScoresEnglish, &snap.ScoresJewish, &snap.ScoresSimple,
+		&snap.ScoresMystery, &snap.ScoresMajestic, &snap.ScoresEights} {
+		*scores = cloneScores(*scores)
+		for _, bucket := range *scores {
+			sort.Strings(bucket)
+		}
+	}
+	if snap.CustomScores != nil {
+		custom := make(map[string]map[uint64][]string, len(snap.CustomScores))
+		for name, scores := range snap.CustomScores {
+			custom[name] = cloneScores(scores)
+			for _, bucket := range custom[name] {
+				sort.Strings(bucket)
+			}
+		}
+		snap.CustomScores = custom
+	}
+	tt.mu.RUnlock()
+	return json.MarshalIndent(snap, "", "  ")
+}
+
+// ScoresJSON returns the compact JSON of the buckets of cipher, built-in or custom, with each bucket sorted and
+// empty buckets left out. JSON object keys must be strings, so each uint64 value is written as its decimal
+// string, such as "336", and encoding/json orders the keys by that string rather than numerically.
+func (tt *Textee) ScoresJSON(cipher Cipher) ([]byte, error) {
+	scores := tt.ScoresFor(cipher)
+	for value, bucket := range scores {
+		if len(bucket) == 0 {
+			delete(scores, value)
+			continue
+		}
+		sort.Strings(bucket)
+	}
+	return json.Marshal(scores)
+}
+
+// orEmpty returns gems, or an empty map when gems is nil
+func orEmpty(gems map[string]gematria.Gematria) map[string]gematria.Gematria {
+	if gems == nil {
+		return make(map[string]gematria.Gematria)
+	}
+	return gems
+}
+
+// orEmptyScores returns scores, or an empty map when scores is nil
+func orEmptyScores(scores map[uint64][]string) map[uint64][]string {
+	if scores == nil {
+		return make(map[uint64][]string)
+	}
+	return scores
+}
@@ -0,0 +1,174 @@
+package textee
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const encodingInput = "All right let's move from this point on 16 March 84, let's move in time to our second location. All right, I will wait."
+
+func TestTextee_Gob(t *testing.T) {
+	tt, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(tt); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	decoded := &Textee{}
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if decoded.Input != tt.Input {
+		t.Errorf("decoded Input = %q, want %q", decoded.Input, tt.Input)
+	}
+	if got, want := quantities(decoded), quantities(tt); !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded SortedSubstrings() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(decoded.ScoresJewish, tt.ScoresJewish) {
+		t.Error("decoded ScoresJewish does not match the original")
+	}
+	if got, want := decoded.Gematrias["lets move"], tt.Gematrias["lets move"]; !sameGematria(got, want) {
+		t.Errorf("decoded Gematrias[lets move] = %v, want %v", got, want)
+	}
+}
+
+// quantities resolves every counter of tt into a plain map for comparisons
+func quantities(tt *Textee) map[string]int {
+	out := make(map[string]int)
+	for _, sq := range tt.SortedSubstrings() {
+		out[sq.Substring] = sq.Quantity
+	}
+	return out
+}
+
+func TestTextee_MarshalJSONIndent(t *testing.T) {
+	first, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	second, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	a, err := first.MarshalJSONIndent()
+	if err != nil {
+		t.Fatalf("MarshalJSONIndent() error = %v", err)
+	}
+	b, err := second.MarshalJSONIndent()
+	if err != nil {
+		t.Fatalf("MarshalJSONIndent() error = %v", err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Error("MarshalJSONIndent() differs between parses of the same input")
+	}
+	if !bytes.Contains(a, []byte("\n  \"subs\": {\n    \"16\": 1,")) {
+		t.Errorf("MarshalJSONIndent() is not indented with sorted substrings:\n%s", a)
+	}
+
+	compact, err := json.Marshal(first)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !bytes.Contains(compact, []byte(`"lets move":2`)) {
+		t.Errorf("json.Marshal() does not carry the substring counts:\n%s", compact)
+	}
+}
+
+func TestTextee_ScoresJSON(t *testing.T) {
+	tt, err := NewTextee("J. L. YY.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	tt.ScoresSimple[99] = nil
+	tt.ScoresSimple[10] = []string{"l", "j"}
+	got, err := tt.ScoresJSON(CipherSimple)
+	if err != nil {
+		t.Fatalf("ScoresJSON() error = %v", err)
+	}
+	// simple gematria: j = 10, l = 12, yy = 50
+	if want := `{"10":["j","l"],"12":["l"],"50":["yy"]}`; string(got) != want {
+		t.Errorf("ScoresJSON() = %s, want %s", got, want)
+	}
+	if got, _ := tt.ScoresJSON("unknown"); string(got) != "{}" {
+		t.Errorf("ScoresJSON(unknown) = %s, want {}", got)
+	}
+}
+
+func TestTextee_UnmarshalJSON(t *testing.T) {
+	tt, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	data, err := json.Marshal(tt)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	decoded := &Textee{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !decoded.Equal(tt) {
+		t.Error("Unmarshal() result is not Equal to the marshaled Textee")
+	}
+	if got, want := quantities(decoded), quantities(tt); !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded SortedSubstrings() = %v, want %v", got, want)
+	}
+	if _, err := decoded.AppendString("Lets move again."); err != nil {
+		t.Fatalf("AppendString() on a decoded Textee error = %v", err)
+	}
+	if decoded.Count("lets move") != tt.Count("lets move")+1 {
+		t.Errorf("Count(lets move) = %d after AppendString, want %d", decoded.Count("lets move"), tt.Count("lets move")+1)
+	}
+
+	var partial map[string]json.RawMessage
+	if err := json.Unmarshal(data, &partial); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	for _, key := range []string{"sen", "sje", "ssi", "smy", "smj", "sei"} {
+		delete(partial, key)
+	}
+	stripped, _ := json.Marshal(partial)
+	rebuilt := &Textee{}
+	if err := json.Unmarshal(stripped, rebuilt); err != nil {
+		t.Fatalf("Unmarshal() without scores error = %v", err)
+	}
+	for _, cipher := range Ciphers() {
+		if !reflect.DeepEqual(rebuilt.ScoresFor(cipher), tt.ScoresFor(cipher)) {
+			t.Errorf("rebuilt %s scores differ from the original", cipher)
+		}
+	}
+
+	hello, err := NewTextee("hello world.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	data, err = json.Marshal(hello)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	reused, err := NewTexteeWithOptions(Options{DominantSurface: true, MaxSubstrings: 1}, "NASA rocks.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if err := json.Unmarshal(data, reused); err != nil {
+		t.Fatalf("Unmarshal() into a used Textee error = %v", err)
+	}
+	if got := reused.SurfaceForms("nasa"); len(got) != 0 {
+		t.Errorf("SurfaceForms(nasa) = %v after decoding over it, want none", got)
+	}
+	if reused.Evictions() != 0 || reused.opts.DominantSurface {
+		t.Errorf("Evictions() = %d, DominantSurface = %v, want the previous state reset", reused.Evictions(), reused.opts.DominantSurface)
+	}
+	if !reused.Equal(hello) {
+		t.Error("Unmarshal() into a used Textee is not Equal to the marshaled one")
+	}
+
+	if err := json.Unmarshal([]byte(`{"subs": [1]}`), &Textee{}); err == nil {
+		t.Error("Unmarshal() of malformed JSON should fail")
+	}
+}
@@ -0,0 +1,41 @@
+package textee
+
+import "github.com/andreimerlescu/gematria"
+
+// Equal reports whether tt and other hold the same substrings with the same counts and the same gematria
+// scores, ignoring map ordering and the mutex. Two nil Textees are equal, a nil and a non-nil one are not.
+func (tt *Textee) Equal(other *Textee) bool {
+	if tt == nil || other == nil {
+		return tt == other
+	}
+	if tt == other {
+		return true
+	}
+	tt.mu.RLock()
+	mine := tt.snapshot()
+	tt.mu.RUnlock()
+	other.mu.RLock()
+	theirs := other.snapshot()
+	other.mu.RUnlock()
+
+	if len(mine.Substrings) != len(theirs.Substrings) || len(mine.Gematrias) != len(theirs.Gematrias) {
+		return false
+	}
+	for substring, quantity := range mine.Substrings {
+		if q, ok := theirs.Substrings[substring]; !ok || q != quantity {
+			return false
+		}
+	}
+	for substring, gem := range mine.Gematrias {
+		if g, ok := theirs.Gematrias[substring]; !ok || !sameGematria(g, gem) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameGematria compares the six cipher scores, ignoring the unexported original string kept by gematria
+func sameGematria(a, b gematria.Gematria) bool {
+	return a.Jewish == b.Jewish && a.English == b.English && a.Simple == b.Simple &&
+		a.Mystery == b.Mystery && a.Majestic == b.Majestic && a.Eights == b.Eights
+}
@@ -0,0 +1,25 @@
+package textee
+
+import "testing"
+
+func TestTextee_Equal(t *testing.T) {
+	a, err := NewTextee("one two. two one.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	b, err := NewTextee("two one. one two.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if !a.Equal(b) {
+		t.Error("Equal() = false for the same sentences in another order")
+	}
+	b.Substrings["one"].Add(1)
+	if a.Equal(b) {
+		t.Error("Equal() = true after changing a count")
+	}
+	var none *Textee
+	if a.Equal(nil) || none.Equal(a) || !none.Equal(nil) {
+		t.Error("Equal() mishandles nil Textees")
+	}
+}
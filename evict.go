@@ -0,0 +1,89 @@
+package textee
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Evictions returns how many substrings were dropped to respect Options.MaxSubstrings since Substrings was
+// last reset, so a nonzero result means the counts of the long tail are approximate
+func (tt *Textee) Evictions() int {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return tt.evictions
+}
+
+// Filter removes every substring for which keep returns false, along with its gematria and Scores* entries, and
+// returns tt. keep is called under the write lock, so it must not call methods of tt.
+func (tt *Textee) Filter(keep func(SubstringQuantity) bool) *Textee {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	for substring, quantity := range tt.Substrings {
+		if !keep(SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())}) {
+			tt.remove(substring)
+		}
+	}
+	return tt
+}
+
+// makeRoom prunes the lowest-count substrings once Substrings holds Options.MaxSubstrings entries, leaving
+// about a tenth of the cap free so pruning runs periodically rather than on every new substring. Ties are
+// evicted in reverse byte order so the result does not depend on map iteration. Callers must hold tt.mu.
+func (tt *Textee) makeRoom() {
+	limit := tt.opts.MaxSubstrings
+	if limit <= 0 || len(tt.Substrings) < limit {
+		return
+	}
+	candidates := make([]SubstringQuantity, 0, len(tt.Substrings))
+	for substring, quantity := range tt.Substrings {
+		candidates = append(candidates, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+	}
+	slices.SortFunc(candidates, func(a, b SubstringQuantity) int {
+		if c := cmp.Compare(a.Quantity, b.Quantity); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Substring, a.Substring)
+	})
+	keep := limit - max(limit/10, 1)
+	for _, candidate := range candidates[:len(candidates)-keep] {
+		tt.evict(candidate.Substring)
+	}
+}
+
+// evict removes substring like remove and counts it towards Evictions. Callers must hold tt.mu.
+func (tt *Textee) evict(substring string) {
+	tt.remove(substring)
+	tt.evictions++
+}
+
+// remove deletes substring from Substrings along with its surface forms, gematria and Scores* entries. Callers
+// must hold tt.mu.
+func (tt *Textee) remove(substring string) {
+	delete(tt.Substrings, substring)
+	delete(tt.surfaces, substring)
+	gem, ok := tt.Gematrias[substring]
+	if !ok {
+		return
+	}
+	delete(tt.Gematrias, substring)
+	for _, cipher := range Ciphers() {
+		removeScore(tt.scores(cipher), cipher.Value(gem), substring)
+	}
+	for name, value := range tt.customCiphers() {
+		removeScore(tt.CustomScores[name], value(substring), substring)
+	}
+}
+
+// removeScore deletes substring from the sorted bucket of value, dropping the bucket once it is empty
+func removeScore(scores map[uint64][]string, value uint64, substring string) {
+	bucket := scores[value]
+	i, found := slices.BinarySearch(bucket, substring)
+	if !found {
+		return
+	}
+	if bucket = slices.Delete(bucket, i, i+1); len(bucket) == 0 {
+		delete(scores, value)
+		return
+	}
+	scores[value] = bucket
+}
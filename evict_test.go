@@ -0,0 +1,124 @@
+package textee
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOptions_MaxSubstrings(t *testing.T) {
+	input := "the cat sat. the cat ran. the cat hid. a dog barked loudly at night."
+	tt, err := NewTexteeWithOptions(Options{MaxSubstrings: 10, MaxNgram: 1}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := len(tt.Substrings); got > 10 {
+		t.Errorf("len(Substrings) = %d, want at most 10", got)
+	}
+	if tt.Evictions() == 0 {
+		t.Error("Evictions() = 0, want evictions once the cap is reached")
+	}
+	for _, substring := range []string{"the", "cat"} {
+		if got := tt.Count(substring); got != 3 {
+			t.Errorf("Count(%q) = %d, want frequent substrings to survive with exact counts", substring, got)
+		}
+	}
+	if len(tt.Gematrias) != len(tt.Substrings) {
+		t.Errorf("len(Gematrias) = %d, want one per tracked substring (%d)", len(tt.Gematrias), len(tt.Substrings))
+	}
+
+	fused, err := NewTexteeWithOptions(Options{MaxSubstrings: 10, MaxNgram: 1, FusedGematria: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for substring := range fused.Gematrias {
+		if _, ok := fused.Substrings[substring]; !ok {
+			t.Errorf("Gematrias[%q] kept for an evicted substring", substring)
+		}
+	}
+
+	unbounded, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if unbounded.Evictions() != 0 {
+		t.Errorf("Evictions() = %d without MaxSubstrings, want 0", unbounded.Evictions())
+	}
+}
+
+func TestTextee_evictScored(t *testing.T) {
+	tt, err := NewTextee("alpha beta gamma")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	gem := tt.Gematrias["beta"]
+	tt.mu.Lock()
+	tt.evict("beta")
+	tt.mu.Unlock()
+	for _, cipher := range Ciphers() {
+		for _, substring := range tt.ScoresFor(cipher)[cipher.Value(gem)] {
+			if substring == "beta" {
+				t.Errorf("%s bucket still holds an evicted substring", cipher)
+			}
+		}
+	}
+	if _, ok := tt.Gematrias["beta"]; ok {
+		t.Error("Gematrias still holds an evicted substring")
+	}
+}
+
+func TestOptions_MinCountAndWordLength(t *testing.T) {
+	input := "A tale of two cities. A tale of two kings. One tale."
+	tt, err := New(input, WithMinCount(2), WithMinWordLength(2))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for _, substring := range []string{"a", "a tale", "cities", "one tale", "one"} {
+		if tt.Contains(substring) {
+			t.Errorf("Substrings[%q] should be dropped", substring)
+		}
+	}
+	for _, substring := range []string{"tale", "tale of", "tale of two", "two"} {
+		if !tt.Contains(substring) {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+	if len(tt.Gematrias) != len(tt.Substrings) {
+		t.Errorf("len(Gematrias) = %d, want only the %d kept substrings scored", len(tt.Gematrias), len(tt.Substrings))
+	}
+
+	fused, err := New(input, WithMinCount(2), func(o *Options) { o.FusedGematria = true })
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for substring := range fused.Gematrias {
+		if !fused.Contains(substring) {
+			t.Errorf("Gematrias[%q] kept for a substring under MinCount", substring)
+		}
+	}
+
+	if _, err := New(input, WithMinCount(-1)); !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("New() error = %v, want %v", err, ErrInvalidOptions)
+	}
+}
+
+func TestTextee_Filter(t *testing.T) {
+	tt, err := NewTextee("I saw a big big dog.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	gem := tt.Gematrias["i"]
+	tt.Filter(func(sq SubstringQuantity) bool { return len(sq.Substring) > 1 && sq.Quantity > 1 })
+	if got := quantities(tt); !reflect.DeepEqual(got, map[string]int{"big": 2}) {
+		t.Errorf("Filter() left %v, want only big", got)
+	}
+	if _, ok := tt.Gematrias["i"]; ok {
+		t.Error("Filter() kept the gematria of a removed substring")
+	}
+	if len(tt.ScoresEnglish[gem.English]) != 0 && tt.ScoresEnglish[gem.English][0] == "i" {
+		t.Error("Filter() kept a removed substring in ScoresEnglish")
+	}
+	if tt.Evictions() != 0 {
+		t.Errorf("Evictions() = %d after Filter, want 0", tt.Evictions())
+	}
+}
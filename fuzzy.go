@@ -0,0 +1,77 @@
+package textee
+
+import "sort"
+
+// MaxFuzzyQuery bounds the runes of a FuzzyMatch query, longer queries match nothing
+const MaxFuzzyQuery = 64
+
+// FuzzyMatch returns the substrings within maxDistance Levenshtein edits of query, normalized like Count, so
+// misspellings such as "goverment" still find "government". Results are ordered by distance, then by quantity
+// descending, then alphabetically. Candidates whose length alone differs by more than maxDistance are skipped
+// without computing a distance.
+func (tt *Textee) FuzzyMatch(query string, maxDistance int) SortedStringQuantities {
+	matches := SortedStringQuantities{}
+	query, err := tt.queryForm(query)
+	q := []rune(query)
+	if err != nil || len(q) == 0 || len(q) > MaxFuzzyQuery || maxDistance < 0 {
+		return matches
+	}
+	distances := make(map[string]int)
+	tt.mu.RLock()
+	for substring, quantity := range tt.Substrings {
+		candidate := []rune(substring)
+		if abs(len(candidate)-len(q)) > maxDistance {
+			continue
+		}
+		if distance, ok := levenshtein(q, candidate, maxDistance); ok {
+			distances[substring] = distance
+			matches = append(matches, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+		}
+	}
+	tt.mu.RUnlock()
+	sort.Slice(matches, func(i, j int) bool {
+		if a, b := distances[matches[i].Substring], distances[matches[j].Substring]; a != b {
+			return a < b
+		}
+		return matches.Less(i, j)
+	})
+	return matches
+}
+
+// levenshtein returns the edit distance between a and b and whether it is within limit, giving up as soon as
+// every entry of a row exceeds limit
+func levenshtein(a, b []rune, limit int) (int, bool) {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		best := current[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			best = min(best, current[j])
+		}
+		if best > limit {
+			return 0, false
+		}
+		previous, current = current, previous
+	}
+	if distance := previous[len(b)]; distance <= limit {
+		return distance, true
+	}
+	return 0, false
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
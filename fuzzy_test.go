@@ -0,0 +1,47 @@
+package textee
+
+import "testing"
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		limit int
+		want  int
+		ok    bool
+	}{
+		{"goverment", "government", 2, 1, true},
+		{"kitten", "sitting", 3, 3, true},
+		{"kitten", "sitting", 2, 0, false},
+		{"", "abc", 3, 3, true},
+		{"same", "same", 0, 0, true},
+	}
+	for _, tc := range tests {
+		got, ok := levenshtein([]rune(tc.a), []rune(tc.b), tc.limit)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("levenshtein(%q, %q, %d) = %d, %v, want %d, %v", tc.a, tc.b, tc.limit, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestTextee_FuzzyMatch(t *testing.T) {
+	tt, err := NewTextee("The government met. The goverment met. The government left. A governor spoke.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.FuzzyMatch("Goverment", 2)
+	want := []SubstringQuantity{{"goverment", 1}, {"government", 2}}
+	if len(got) != len(want) {
+		t.Fatalf("FuzzyMatch() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("FuzzyMatch()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got := tt.FuzzyMatch("government", 0); len(got) != 1 || got[0].Substring != "government" {
+		t.Errorf("FuzzyMatch() with no edits = %v, want only the exact match", got)
+	}
+	if got := tt.FuzzyMatch("", 3); got == nil || len(got) != 0 {
+		t.Errorf("FuzzyMatch() empty query = %v, want an empty result", got)
+	}
+}
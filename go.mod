@@ -1,5 +1,5 @@
 module github.com/andreimerlescu/textee
 
-go 1.21
+go 1.23
 
 require github.com/andreimerlescu/gematria v1.0.1
@@ -0,0 +1,97 @@
+package textee
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// GraphOptions configures the graph WriteDOT emits
+type GraphOptions struct {
+	// MinQuantity drops substrings counted fewer times, along with their edges
+	MinQuantity int
+
+	// Cipher links substrings that share a value under it, empty uses CipherEnglish
+	Cipher Cipher
+
+	// CoOccurrence links single words appearing in the same sentence instead of substrings sharing a value
+	CoOccurrence bool
+}
+
+// WriteDOT writes tt to w as an undirected Graphviz DOT graph. Nodes are substrings labelled with their
+// quantity and edges join substrings sharing a value under opts.Cipher, labelled with that value, or words
+// co-occurring in a sentence when opts.CoOccurrence is set, labelled with the sentence count. Nodes and edges
+// are written in sorted order so the output is stable.
+func (tt *Textee) WriteDOT(w io.Writer, opts GraphOptions) error {
+	nodes := make(map[string]int)
+	var names []string
+	for _, sq := range tt.sortedKeys() {
+		if sq.Quantity < opts.MinQuantity || (opts.CoOccurrence && !isUnigram(sq.Substring)) {
+			continue
+		}
+		nodes[sq.Substring] = sq.Quantity
+		names = append(names, sq.Substring)
+	}
+	sort.Strings(names)
+
+	type edge struct {
+		a, b  string
+		label uint64
+	}
+	var edges []edge
+	if opts.CoOccurrence {
+		for a, counts := range tt.CoOccurrence() {
+			for b, count := range counts {
+				_, hasA := nodes[a]
+				_, hasB := nodes[b]
+				if a < b && hasA && hasB {
+					edges = append(edges, edge{a, b, uint64(count)})
+				}
+			}
+		}
+	} else {
+		cipher := opts.Cipher
+		if cipher == "" {
+			cipher = CipherEnglish
+		}
+		tt.mu.RLock()
+		for value, bucket := range tt.scores(cipher) {
+			var linked []string
+			for _, substring := range UnionScores(value, bucket, nil) {
+				if _, ok := nodes[substring]; ok {
+					linked = append(linked, substring)
+				}
+			}
+			for i := 0; i < len(linked); i++ {
+				for j := i + 1; j < len(linked); j++ {
+					edges = append(edges, edge{linked[i], linked[j], value})
+				}
+			}
+		}
+		tt.mu.RUnlock()
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].a != edges[j].a {
+			return edges[i].a < edges[j].a
+		}
+		return edges[i].b < edges[j].b
+	})
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "graph textee {")
+	for _, name := range names {
+		fmt.Fprintf(out, "\t%s [label=%s];\n", dotQuote(name), dotQuote(fmt.Sprintf("%s (%d)", name, nodes[name])))
+	}
+	for _, e := range edges {
+		fmt.Fprintf(out, "\t%s -- %s [label=\"%d\"];\n", dotQuote(e.a), dotQuote(e.b), e.label)
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// dotQuote returns s as a double quoted DOT identifier, escaping backslashes, quotes and line breaks
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "").Replace(s) + `"`
+}
@@ -0,0 +1,51 @@
+package textee
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTextee_WriteDOT(t *testing.T) {
+	tt, err := NewTextee("abc cba. abc dog.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+
+	var scores strings.Builder
+	if err := tt.WriteDOT(&scores, GraphOptions{MinQuantity: 1}); err != nil {
+		t.Fatalf("WriteDOT() error = %v", err)
+	}
+	dot := scores.String()
+	if !strings.HasPrefix(dot, "graph textee {\n") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("WriteDOT() = %q, want a graph block", dot)
+	}
+	gem, _ := tt.GematriaOf("abc")
+	for _, want := range []string{
+		`"abc" [label="abc (2)"];`,
+		`"abc cba" [label="abc cba (1)"];`,
+		`"abc" -- "cba" [label="` + strconv.FormatUint(gem.English, 10) + `"];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("WriteDOT() is missing %q in\n%s", want, dot)
+		}
+	}
+
+	var frequent strings.Builder
+	_ = tt.WriteDOT(&frequent, GraphOptions{MinQuantity: 2})
+	if strings.Contains(frequent.String(), `"cba"`) {
+		t.Error("WriteDOT() should drop substrings below MinQuantity")
+	}
+
+	var words strings.Builder
+	_ = tt.WriteDOT(&words, GraphOptions{CoOccurrence: true})
+	if !strings.Contains(words.String(), `"abc" -- "dog" [label="1"];`) || strings.Contains(words.String(), `"abc cba"`) {
+		t.Errorf("WriteDOT() co-occurrence graph = %s", words.String())
+	}
+}
+
+func TestDotQuote(t *testing.T) {
+	if got, want := dotQuote(`say "hi" \ now`), `"say \"hi\" \\ now"`; got != want {
+		t.Errorf("dotQuote() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+package textee
+
+// ScoreHistogram returns how many distinct substrings fall on each value of the cipher
+func (tt *Textee) ScoreHistogram(cipher Cipher) map[uint64]int {
+	return tt.ScoreHistogramBuckets(cipher, 1)
+}
+
+// ScoreHistogramBuckets groups cipher values into ranges of width and returns how many distinct substrings
+// fall in each range, keyed by the lowest value of the range. A width of 0 is treated as 1.
+func (tt *Textee) ScoreHistogramBuckets(cipher Cipher, width uint64) map[uint64]int {
+	if width == 0 {
+		width = 1
+	}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	histogram := make(map[uint64]int)
+	for value, bucket := range tt.scores(cipher) {
+		if len(bucket) == 0 {
+			continue
+		}
+		histogram[value-value%width] += len(bucket)
+	}
+	return histogram
+}
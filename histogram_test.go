@@ -0,0 +1,20 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextee_ScoreHistogram(t *testing.T) {
+	// simple gematria: j = 10, l = 12, yy = 50, ab = ba = 3
+	tt, err := NewTextee("J. L. YY. AB. BA.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got, want := tt.ScoreHistogram(CipherSimple), map[uint64]int{3: 2, 10: 1, 12: 1, 50: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ScoreHistogram() = %v, want %v", got, want)
+	}
+	if got, want := tt.ScoreHistogramBuckets(CipherSimple, 10), map[uint64]int{0: 2, 10: 2, 50: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ScoreHistogramBuckets() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,218 @@
+package textee
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// CounterFor returns the live counter behind substring, or nil when it has not been seen. The lookup takes the
+// read lock but calls on the returned pointer do not, so this is an escape hatch for hot-path readers only:
+// Load is always safe, but ParseString replaces every counter and the pointer stops tracking tt afterwards.
+func (tt *Textee) CounterFor(substring string) *atomic.Int32 {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return tt.Substrings[substring]
+}
+
+// Count returns how many times substring was counted in tt, or 0 when it never was. The query goes through
+// the same tokenizing, number folding, stemming, stopword removal, casing and cleaning as parsed text, so it
+// matches the key substring would have been stored under.
+func (tt *Textee) Count(substring string) int {
+	key, ok := tt.key(substring)
+	if !ok {
+		return 0
+	}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	if quantity, ok := tt.Substrings[key]; ok {
+		return int(quantity.Load())
+	}
+	return 0
+}
+
+// Contains reports whether substring was counted in tt at least once, normalizing it like Count
+func (tt *Textee) Contains(substring string) bool {
+	return tt.Count(substring) > 0
+}
+
+// key returns the Substrings key s would be counted under by ParseString, and false when it would not be
+func (tt *Textee) key(s string) (string, bool) {
+	words := tt.tokens(s)
+	substring := strings.Join(words, " ")
+	if stop := tt.opts.stopwordSet(); stop != nil {
+		var keep bool
+		if substring, keep = tt.withoutStopwords(words, stop); !keep {
+			return "", false
+		}
+	}
+	key, err := tt.normalize(substring)
+	return key, err == nil && key != ""
+}
+
+// queryForm returns the text a partial or approximate query for s is compared with: the key s would be counted
+// under, or its cleaned and lowercased form when it would never be counted, such as a lone stopword that may
+// still begin a longer word
+func (tt *Textee) queryForm(s string) (string, error) {
+	if key, ok := tt.key(s); ok {
+		return key, nil
+	}
+	return tt.normalize(s)
+}
+
+// WordFrequencies returns a fresh map of the single-word substrings and their counts
+func (tt *Textee) WordFrequencies() map[string]int {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	words := make(map[string]int)
+	for substring, quantity := range tt.Substrings {
+		if isUnigram(substring) {
+			words[substring] = int(quantity.Load())
+		}
+	}
+	return words
+}
+
+// TotalOccurrences returns the sum of every substring count, the number of n-gram instances counted in tt
+func (tt *Textee) TotalOccurrences() int {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	total := 0
+	for _, quantity := range tt.Substrings {
+		total += int(quantity.Load())
+	}
+	return total
+}
+
+// SubstringFrequency is a substring with its quantity and its share of TotalOccurrences
+type SubstringFrequency struct {
+	SubstringQuantity
+	Frequency float64 `json:"f"`
+}
+
+// SortedSubstringsWithFrequency returns SortedSubstrings with each quantity divided by the total of all of
+// them, so the frequencies sum to 1. An empty Textee returns an empty result.
+func (tt *Textee) SortedSubstringsWithFrequency() []SubstringFrequency {
+	sorted := tt.SortedSubstrings()
+	total := 0
+	for _, sq := range sorted {
+		total += sq.Quantity
+	}
+	frequencies := make([]SubstringFrequency, len(sorted))
+	for i, sq := range sorted {
+		frequencies[i].SubstringQuantity = sq
+		if total > 0 {
+			frequencies[i].Frequency = float64(sq.Quantity) / float64(total)
+		}
+	}
+	return frequencies
+}
+
+// FrequencyDistribution returns a fresh map from each occurrence count to the number of substrings seen that
+// many times, so [1] is the number of hapax legomena
+func (tt *Textee) FrequencyDistribution() map[int]int {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	distribution := make(map[int]int)
+	for _, quantity := range tt.Substrings {
+		distribution[int(quantity.Load())]++
+	}
+	return distribution
+}
+
+// BottomN returns the n least frequent substrings ordered by quantity ascending, then alphabetically, or all of
+// them when n exceeds the number of substrings. A non-positive n returns an empty result.
+func (tt *Textee) BottomN(n int) SortedStringQuantities {
+	bottom := SortedStringQuantities{}
+	if n <= 0 {
+		return bottom
+	}
+	tt.mu.RLock()
+	for substring, quantity := range tt.Substrings {
+		bottom = append(bottom, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+	}
+	tt.mu.RUnlock()
+	sort.Slice(bottom, func(i, j int) bool {
+		if bottom[i].Quantity != bottom[j].Quantity {
+			return bottom[i].Quantity < bottom[j].Quantity
+		}
+		return bottom[i].Substring < bottom[j].Substring
+	})
+	if len(bottom) > n {
+		bottom = bottom[:n]
+	}
+	return bottom
+}
+
+// Search returns the substrings matching the regular expression pattern with their quantities, sorted like
+// SortedSubstrings, or a RegexpError when pattern does not compile
+func (tt *Textee) Search(pattern string) (SortedStringQuantities, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPattern, err)
+	}
+	return tt.matching(re.MatchString), nil
+}
+
+// matching returns the substrings accepted by match with their quantities, sorted like SortedSubstrings
+func (tt *Textee) matching(match func(substring string) bool) SortedStringQuantities {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	matches := SortedStringQuantities{}
+	for substring, quantity := range tt.Substrings {
+		if match(substring) {
+			matches = append(matches, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+		}
+	}
+	sort.Sort(matches)
+	return matches
+}
+
+// Ngrams returns the substrings of exactly n words with their quantities, sorted like SortedSubstrings. An n
+// below 1 or outside the configured MinNgram to MaxNgram range returns an empty result.
+func (tt *Textee) Ngrams(n int) SortedStringQuantities {
+	if minimum, maximum := tt.opts.ngramRange(); n < 1 || n < minimum || n > maximum {
+		return SortedStringQuantities{}
+	}
+	return tt.matching(func(substring string) bool { return len(strings.Fields(substring)) == n })
+}
+
+// WithPrefix returns the substrings starting with prefix, normalized like Count to match the stored form
+func (tt *Textee) WithPrefix(prefix string) SortedStringQuantities {
+	prefix, _ = tt.queryForm(prefix)
+	return tt.matching(func(substring string) bool { return strings.HasPrefix(substring, prefix) })
+}
+
+// WithSuffix returns the substrings ending with suffix, normalized like Count to match the stored form
+func (tt *Textee) WithSuffix(suffix string) SortedStringQuantities {
+	suffix, _ = tt.queryForm(suffix)
+	return tt.matching(func(substring string) bool { return strings.HasSuffix(substring, suffix) })
+}
+
+// Rank places substring, normalized like Count, in the frequency ordering of tt. The rank is 1-based and shared
+// by substrings with equal counts, the next count down skipping past them as in 1, 1, 3. Percentile is 100 for
+// the top rank, falling towards 0 for the rarest, and ok is false when the substring is not present.
+func (tt *Textee) Rank(substring string) (rank int, total int, percentile float64, ok bool) {
+	substring, ok = tt.key(substring)
+	if !ok {
+		return 0, 0, 0, false
+	}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	total = len(tt.Substrings)
+	counter, ok := tt.Substrings[substring]
+	if !ok {
+		return 0, total, 0, false
+	}
+	quantity := counter.Load()
+	rank = 1
+	for _, other := range tt.Substrings {
+		if other.Load() > quantity {
+			rank++
+		}
+	}
+	percentile = 100 * float64(total-rank+1) / float64(total)
+	return rank, total, percentile, true
+}
@@ -0,0 +1,240 @@
+package textee
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTextee_CounterFor(t *testing.T) {
+	tt, err := NewTextee("one two. one.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	counter := tt.CounterFor("one")
+	if counter == nil {
+		t.Fatal("CounterFor(one) = nil, want a counter")
+	}
+	if got := counter.Load(); got != 2 {
+		t.Errorf("CounterFor(one).Load() = %d, want 2", got)
+	}
+	tt.Substrings["one"].Add(1)
+	if got := counter.Load(); got != 3 {
+		t.Errorf("CounterFor(one).Load() after increment = %d, want 3", got)
+	}
+	if tt.CounterFor("missing") != nil {
+		t.Error("CounterFor(missing) should be nil")
+	}
+}
+
+func TestTextee_GematriaOf(t *testing.T) {
+	tt, err := NewTextee("manifesting three six nine")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got, err := tt.GematriaOf("  Manifesting! ")
+	if err != nil {
+		t.Fatalf("GematriaOf() error = %v", err)
+	}
+	if want := tt.Gematrias["manifesting"]; !sameGematria(got, want) {
+		t.Errorf("GematriaOf() = %v, want %v", got, want)
+	}
+	miss, err := tt.GematriaOf("Absent")
+	if err != nil {
+		t.Fatalf("GematriaOf() error = %v", err)
+	}
+	if miss.Simple != 61 {
+		t.Errorf("GematriaOf(Absent).Simple = %d, want 61", miss.Simple)
+	}
+}
+
+func TestTextee_WordFrequencies(t *testing.T) {
+	tt, err := NewTextee("one two. one.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.WordFrequencies()
+	want := map[string]int{"one": 2, "two": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WordFrequencies() = %v, want %v", got, want)
+	}
+	got["one"] = 99
+	if tt.Substrings["one"].Load() != 2 {
+		t.Error("mutating WordFrequencies() changed the Textee")
+	}
+}
+
+func TestTextee_Search(t *testing.T) {
+	tt, err := NewTextee("God is good. Goddess of 1984.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got, err := tt.Search(`^god`)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	want := SortedStringQuantities{
+		{Substring: "god", Quantity: 1},
+		{Substring: "god is", Quantity: 1},
+		{Substring: "god is good", Quantity: 1},
+		{Substring: "goddess", Quantity: 1},
+		{Substring: "goddess of", Quantity: 1},
+		{Substring: "goddess of 1984", Quantity: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+	if _, err := tt.Search(`(`); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Search() error = %v, want %v", err, ErrInvalidPattern)
+	}
+}
+
+func TestTextee_WithPrefixSuffix(t *testing.T) {
+	tt, err := NewTextee("New York. New Jersey. Old York.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	prefixed := SortedStringQuantities{
+		{Substring: "new", Quantity: 2},
+		{Substring: "new jersey", Quantity: 1},
+		{Substring: "new york", Quantity: 1},
+	}
+	if got := tt.WithPrefix("NEW"); !reflect.DeepEqual(got, prefixed) {
+		t.Errorf("WithPrefix(NEW) = %v, want %v", got, prefixed)
+	}
+	suffixed := SortedStringQuantities{
+		{Substring: "york", Quantity: 2},
+		{Substring: "new york", Quantity: 1},
+		{Substring: "old york", Quantity: 1},
+	}
+	if got := tt.WithSuffix(" York!"); !reflect.DeepEqual(got, suffixed) {
+		t.Errorf("WithSuffix(York!) = %v, want %v", got, suffixed)
+	}
+}
+
+func TestTextee_Rank(t *testing.T) {
+	// a: 3, a b: 2, b: 2, then a b c, b c and c once each
+	tt, err := NewTextee("a b c. a b. a.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	rank, total, percentile, ok := tt.Rank("A")
+	if !ok || rank != 1 || total != 6 || percentile != 100 {
+		t.Errorf("Rank(A) = %d, %d, %v, %v, want 1, 6, 100, true", rank, total, percentile, ok)
+	}
+	rankB, _, _, _ := tt.Rank("b")
+	rankAB, _, _, _ := tt.Rank("a b")
+	if rankB != 2 || rankAB != 2 {
+		t.Errorf("Rank(b), Rank(a b) = %d, %d, want tied at 2", rankB, rankAB)
+	}
+	if rank, _, _, _ := tt.Rank("c"); rank != 4 {
+		t.Errorf("Rank(c) = %d, want 4", rank)
+	}
+	if _, _, _, ok := tt.Rank("missing"); ok {
+		t.Error("Rank(missing) ok = true, want false")
+	}
+}
+
+func TestTextee_FrequencyDistribution(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish. blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	// fish 3; red, red fish 2; blue, blue fish 1
+	want := map[int]int{3: 1, 2: 2, 1: 2}
+	if got := tt.FrequencyDistribution(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FrequencyDistribution() = %v, want %v", got, want)
+	}
+}
+
+func TestTextee_BottomN(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish. blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := SortedStringQuantities{{"blue", 1}, {"blue fish", 1}, {"red", 2}}
+	if got := tt.BottomN(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("BottomN(3) = %v, want %v", got, want)
+	}
+	if got := tt.BottomN(0); got == nil || len(got) != 0 {
+		t.Errorf("BottomN(0) = %v, want an empty result", got)
+	}
+	if got := tt.BottomN(100); len(got) != len(tt.Substrings) || got[len(got)-1].Substring != "fish" {
+		t.Errorf("BottomN(100) = %v, want every substring ending with fish", got)
+	}
+}
+
+func TestTextee_TotalOccurrences(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish. blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	// fish 3, red 2, red fish 2, blue 1, blue fish 1
+	if got := tt.TotalOccurrences(); got != 9 {
+		t.Errorf("TotalOccurrences() = %d, want 9", got)
+	}
+}
+
+func TestTextee_Ngrams(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish swims.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := SortedStringQuantities{{"red fish", 2}, {"fish swims", 1}}
+	if got := tt.Ngrams(2); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ngrams(2) = %v, want %v", got, want)
+	}
+	if got := tt.Ngrams(3); len(got) != 1 || got[0].Substring != "red fish swims" {
+		t.Errorf("Ngrams(3) = %v, want [red fish swims]", got)
+	}
+	for _, n := range []int{0, -1, 4} {
+		if got := tt.Ngrams(n); got == nil || len(got) != 0 {
+			t.Errorf("Ngrams(%d) = %v, want an empty result", n, got)
+		}
+	}
+}
+
+func TestTextee_Count(t *testing.T) {
+	tt, err := NewTextee("Red fish. red fish! Blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	tests := map[string]int{"red fish": 2, "  RED   Fish! ": 2, "fish": 3, "green": 0, "": 0, "...": 0}
+	for query, want := range tests {
+		if got := tt.Count(query); got != want {
+			t.Errorf("Count(%q) = %d, want %d", query, got, want)
+		}
+	}
+	if !tt.Contains("Blue Fish") || tt.Contains("green fish") {
+		t.Error("Contains() should match blue fish only")
+	}
+
+	stemmed, err := NewTexteeWithOptions(Options{Stemmer: SuffixStemmer, CanonicalStopwords: true}, "The king of castles.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := stemmed.Count("King of Castles"); got != 1 {
+		t.Errorf("Count() with a stemmer and stopwords = %d, want 1 in %v", got, stemmed.Substrings)
+	}
+}
+
+func TestTextee_SortedSubstringsWithFrequency(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish. blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	got := tt.SortedSubstringsWithFrequency()
+	if len(got) != 5 || got[0].Substring != "fish" || got[0].Frequency != 3.0/9.0 {
+		t.Fatalf("SortedSubstringsWithFrequency() = %v, want fish first at 3/9", got)
+	}
+	sum := 0.0
+	for _, sf := range got {
+		sum += sf.Frequency
+	}
+	if sum < 0.999999 || sum > 1.000001 {
+		t.Errorf("frequencies sum to %v, want 1", sum)
+	}
+	if got := (&Textee{}).SortedSubstringsWithFrequency(); len(got) != 0 {
+		t.Errorf("SortedSubstringsWithFrequency() on an empty Textee = %v, want empty", got)
+	}
+}
@@ -0,0 +1,34 @@
+package textee
+
+import "regexp"
+
+var (
+	regMarkdownFence      = regexp.MustCompile("(?ms)^[ \t]*(?:```.*?^[ \t]*```|~~~.*?^[ \t]*~~~)[^\n]*$")
+	regMarkdownCode       = regexp.MustCompile("`[^`\n]*`")
+	regMarkdownImage      = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	regMarkdownLink       = regexp.MustCompile(`\[([^\]]*)\](?:\([^)]*\)|\[[^\]]*\])`)
+	regMarkdownDefinition = regexp.MustCompile(`(?m)^[ \t]*\[[^\]]+\]:[ \t]*\S+.*$`)
+	regMarkdownURL        = regexp.MustCompile(`<?\b(?:https?|ftp)://[^\s>]+>?`)
+	regMarkdownHeader     = regexp.MustCompile(`(?m)^[ \t]{0,3}#{1,6}[ \t]+(.*?)[ \t#]*$`)
+	regMarkdownRule       = regexp.MustCompile(`(?m)^[ \t]*(?:[-*_][ \t]*){3,}$`)
+	regMarkdownQuote      = regexp.MustCompile(`(?m)^[ \t]*(?:>[ \t]?)+`)
+	regMarkdownList       = regexp.MustCompile(`(?m)^[ \t]*(?:[*+-]|\d+[.)])[ \t]+`)
+	regMarkdownEmphasis   = regexp.MustCompile(`[*_~]+`)
+)
+
+// stripMarkdown removes Markdown formatting from input without a full CommonMark parse. Fenced code blocks,
+// inline code, URLs and reference definitions are dropped, links and images keep only their text, headers end
+// with a period so they form their own sentence, and rules, quote, list and emphasis markers are removed.
+func stripMarkdown(input string) string {
+	input = regMarkdownFence.ReplaceAllString(input, "")
+	input = regMarkdownCode.ReplaceAllString(input, " ")
+	input = regMarkdownImage.ReplaceAllString(input, "$1")
+	input = regMarkdownLink.ReplaceAllString(input, "$1")
+	input = regMarkdownDefinition.ReplaceAllString(input, "")
+	input = regMarkdownURL.ReplaceAllString(input, " ")
+	input = regMarkdownHeader.ReplaceAllString(input, "$1.")
+	input = regMarkdownRule.ReplaceAllString(input, "")
+	input = regMarkdownQuote.ReplaceAllString(input, "")
+	input = regMarkdownList.ReplaceAllString(input, "")
+	return regMarkdownEmphasis.ReplaceAllString(input, "")
+}
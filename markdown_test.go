@@ -0,0 +1,40 @@
+package textee
+
+import "testing"
+
+func TestStripMarkdown(t *testing.T) {
+	input := "# Getting Started ##\n" +
+		"Read the **full** [guide](https://example.com/guide) and _enjoy_ it.\n" +
+		"```go\nfunc main() { panic(\"salad\") }\n```\n" +
+		"- Run `go test` first.\n" +
+		"> Quoted ![logo](img.png) text.\n" +
+		"---\n" +
+		"[guide]: https://example.com/guide\n"
+	want := "Getting Started.\n" +
+		"Read the full guide and enjoy it.\n" +
+		"\n" +
+		"Run   first.\n" +
+		"Quoted logo text.\n" +
+		"\n" +
+		"\n"
+	if got := stripMarkdown(input); got != want {
+		t.Errorf("stripMarkdown() = %q, want %q", got, want)
+	}
+}
+
+func TestOptions_StripMarkdown(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{StripMarkdown: true}, "## Intro\nSee [the docs](https://example.com/x).\n```\nsalad words\n```\n")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"intro", "the docs", "see the docs"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+	for _, substring := range []string{"salad", "https", "example", "intro see"} {
+		if _, ok := tt.Substrings[substring]; ok {
+			t.Errorf("Substrings[%q] should have been stripped", substring)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package textee
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// ScoreResolver decides how two buckets that share the same gematria value are combined during a merge
+type ScoreResolver func(value uint64, a, b []string) []string
+
+// UnionScores is the default ScoreResolver, it returns the sorted union of a and b without duplicates
+func UnionScores(value uint64, a, b []string) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	union := make([]string, 0, len(a)+len(b))
+	for _, bucket := range [][]string{a, b} {
+		for _, substring := range bucket {
+			if _, ok := seen[substring]; ok {
+				continue
+			}
+			seen[substring] = struct{}{}
+			union = append(union, substring)
+		}
+	}
+	sort.Strings(union)
+	return union
+}
+
+// Merge returns a new *Textee that sums the substring counts of tt and others and unions their Scores* buckets,
+// so shards of a corpus parsed in parallel combine into one result. The sources are only read, each under its
+// own read lock, and must not be modified until Merge returns, see MergeFunc for the rest.
+func (tt *Textee) Merge(others ...*Textee) *Textee {
+	return tt.MergeFunc(UnionScores, others...)
+}
+
+// MergeFunc returns a new *Textee that sums the substring counts of tt and others, using resolver to combine
+// Scores* buckets that exist on both sides for the same value. A nil resolver falls back to UnionScores and a
+// resolver returning an empty slice removes the bucket from the result. Gematria already calculated on the
+// sources is reused rather than recomputed, and a substring no source scored is left for CalculateGematriaFor.
+// Sentences and surface forms are combined as well and the result parses with the Options of the first
+// non-nil source. Its MaxSubstrings applies to the merged counts, so once they are summed the lowest are evicted
+// down to the cap and Evictions reports those together with the evictions of every source.
+func (tt *Textee) MergeFunc(resolver ScoreResolver, others ...*Textee) *Textee {
+	if resolver == nil {
+		resolver = UnionScores
+	}
+	merged := &Textee{
+		Substrings:     make(map[string]*atomic.Int32),
+		Gematrias:      make(map[string]gematria.Gematria),
+		ScoresEnglish:  make(map[uint64][]string),
+		ScoresJewish:   make(map[uint64][]string),
+		ScoresSimple:   make(map[uint64][]string),
+		ScoresMystery:  make(map[uint64][]string),
+		ScoresMajestic: make(map[uint64][]string),
+		ScoresEights:   make(map[uint64][]string),
+	}
+	var inputs []string
+	first := true
+	for _, source := range append([]*Textee{tt}, others...) {
+		if source == nil {
+			continue
+		}
+		source.mu.RLock()
+		if first {
+			merged.opts, merged.redactor = source.opts, source.redactor
+			first = false
+		}
+		merged.sentences = append(merged.sentences, source.sentences...)
+		for substring, forms := range source.surfaces {
+			if merged.surfaces == nil {
+				merged.surfaces = make(map[string]map[string]int)
+			}
+			for surface, count := range forms {
+				merged.recordSurface(substring, surface, count)
+			}
+		}
+		if source.Input != "" {
+			inputs = append(inputs, source.Input)
+		}
+		merged.Gematria = addGematria(merged.Gematria, source.Gematria)
+		merged.evictions += source.evictions
+		for substring, quantity := range source.Substrings {
+			if _, ok := merged.Substrings[substring]; !ok {
+				merged.Substrings[substring] = new(atomic.Int32)
+			}
+			merged.Substrings[substring].Add(quantity.Load())
+		}
+		for substring, gem := range source.Gematrias {
+			if _, ok := merged.Gematrias[substring]; !ok {
+				merged.Gematrias[substring] = gem
+			}
+		}
+		mergeScores(merged.ScoresEnglish, source.ScoresEnglish, resolver)
+		mergeScores(merged.ScoresJewish, source.ScoresJewish, resolver)
+		mergeScores(merged.ScoresSimple, source.ScoresSimple, resolver)
+		mergeScores(merged.ScoresMystery, source.ScoresMystery, resolver)
+		mergeScores(merged.ScoresMajestic, source.ScoresMajestic, resolver)
+		mergeScores(merged.ScoresEights, source.ScoresEights, resolver)
+		for name, scores := range source.CustomScores {
+			if merged.CustomScores == nil {
+				merged.CustomScores = make(map[string]map[uint64][]string)
+			}
+			if merged.CustomScores[name] == nil {
+				merged.CustomScores[name] = make(map[uint64][]string)
+			}
+			mergeScores(merged.CustomScores[name], scores, resolver)
+		}
+		source.mu.RUnlock()
+	}
+	merged.Input = strings.Join(inputs, " ")
+	if limit := merged.opts.MaxSubstrings; limit > 0 && len(merged.Substrings) > limit {
+		merged.makeRoom()
+	}
+	return merged
+}
+
+// mergeScores folds the buckets of src into dst, calling resolver when both contain the same value. Every bucket
+// written to dst is sorted and deduplicated, whatever the resolver returns.
+func mergeScores(dst, src map[uint64][]string, resolver ScoreResolver) {
+	for value, bucket := range src {
+		existing, ok := dst[value]
+		if !ok {
+			dst[value] = uniqueBucket(append([]string(nil), bucket...))
+			continue
+		}
+		combined := resolver(value, existing, bucket)
+		if len(combined) == 0 {
+			delete(dst, value)
+			continue
+		}
+		dst[value] = uniqueBucket(append([]string(nil), combined...))
+	}
+}
+
+// uniqueBucket sorts bucket in place and drops repeated substrings
+func uniqueBucket(bucket []string) []string {
+	sort.Strings(bucket)
+	return slices.Compact(bucket)
+}
+
+// addGematria sums each cipher of a and b, matching the score of the two inputs joined together
+func addGematria(a, b gematria.Gematria) gematria.Gematria {
+	return gematria.Gematria{
+		Jewish:   a.Jewish + b.Jewish,
+		English:  a.English + b.English,
+		Simple:   a.Simple + b.Simple,
+		Mystery:  a.Mystery + b.Mystery,
+		Majestic: a.Majestic + b.Majestic,
+		Eights:   a.Eights + b.Eights,
+	}
+}
+
+// Intersect returns a new *Textee holding only the substrings counted by both tt and other, each with the
+// smaller of its two counts. Gematria already calculated on either side is reused to fill Gematrias and the
+// Scores* buckets, Input and Gematria are left empty and the result parses with the Options of tt. A nil other
+// shares nothing.
+func (tt *Textee) Intersect(other *Textee) *Textee {
+	mine, theirs := tt.counts(), other.counts()
+	shared := &Textee{
+		Substrings:     make(map[string]*atomic.Int32),
+		Gematrias:      make(map[string]gematria.Gematria),
+		ScoresEnglish:  make(map[uint64][]string),
+		ScoresJewish:   make(map[uint64][]string),
+		ScoresSimple:   make(map[uint64][]string),
+		ScoresMystery:  make(map[uint64][]string),
+		ScoresMajestic: make(map[uint64][]string),
+		ScoresEights:   make(map[uint64][]string),
+	}
+	if tt != nil {
+		shared.opts, shared.redactor = tt.opts, tt.redactor
+	}
+	for substring, quantity := range mine {
+		if q, ok := theirs[substring]; ok {
+			shared.Substrings[substring] = new(atomic.Int32)
+			shared.Substrings[substring].Store(int32(min(quantity, q)))
+		}
+	}
+	for _, source := range []*Textee{tt, other} {
+		if source == nil {
+			continue
+		}
+		source.mu.RLock()
+		for substring := range shared.Substrings {
+			if _, done := shared.Gematrias[substring]; done {
+				continue
+			}
+			if gem, ok := source.Gematrias[substring]; ok {
+				shared.Gematrias[substring] = gem
+			}
+		}
+		source.mu.RUnlock()
+	}
+	custom := shared.customCiphers()
+	if len(custom) > 0 {
+		shared.CustomScores = make(map[string]map[uint64][]string)
+		for name := range custom {
+			shared.CustomScores[name] = make(map[uint64][]string)
+		}
+	}
+	for substring, gem := range shared.Gematrias {
+		for _, cipher := range Ciphers() {
+			insertScore(shared.scores(cipher), cipher.Value(gem), substring)
+		}
+		for name, value := range custom {
+			insertScore(shared.CustomScores[name], value(substring), substring)
+		}
+	}
+	return shared
+}
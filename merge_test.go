@@ -0,0 +1,174 @@
+package textee
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestTextee_MergeFunc(t *testing.T) {
+	left, err := NewTextee("abc cab")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	right, err := NewTextee("abc bca")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+
+	intersect := func(value uint64, a, b []string) []string {
+		inA := make(map[string]bool, len(a))
+		for _, s := range a {
+			inA[s] = true
+		}
+		var out []string
+		for _, s := range b {
+			if inA[s] {
+				out = append(out, s)
+			}
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	merged := left.MergeFunc(intersect, right)
+	if got, want := merged.ScoresEnglish[36], []string{"abc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ScoresEnglish[36] = %v, want %v", got, want)
+	}
+	if bucket, ok := merged.ScoresEnglish[72]; ok {
+		t.Errorf("ScoresEnglish[72] = %v, want the bucket removed", bucket)
+	}
+	if got := merged.Substrings["abc"].Load(); got != 2 {
+		t.Errorf("Substrings[abc] = %d, want 2", got)
+	}
+
+	union := left.Merge(right)
+	if got, want := union.ScoresEnglish[36], []string{"abc", "bca", "cab"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() ScoresEnglish[36] = %v, want %v", got, want)
+	}
+}
+
+func TestTextee_ScoresWithoutDuplicates(t *testing.T) {
+	left, err := NewTextee("the light of the world")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	right, err := NewTextee("the light of day and the light of the world")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	concat := func(value uint64, a, b []string) []string { return append(append([]string(nil), a...), b...) }
+	merged := left.MergeFunc(concat, right, left)
+
+	if err := left.ParseTokens([]string{"the", "light", "of", "day"}); err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	if _, err := left.CalculateGematriaFor([]string{"light", "light of day", "light of day"}); err != nil {
+		t.Fatalf("CalculateGematriaFor() error = %v", err)
+	}
+	if _, err := right.CalculateGematria(); err != nil {
+		t.Fatalf("CalculateGematria() error = %v", err)
+	}
+
+	for name, tt := range map[string]*Textee{"merged": merged, "incremental": left, "recalculated": right} {
+		for _, cipher := range Ciphers() {
+			for value, bucket := range tt.ScoresFor(cipher) {
+				seen := make(map[string]bool, len(bucket))
+				for _, substring := range bucket {
+					if seen[substring] {
+						t.Errorf("%s %s[%d] = %v, holds %q more than once", name, cipher, value, bucket, substring)
+					}
+					seen[substring] = true
+				}
+			}
+		}
+	}
+}
+
+func TestTextee_MergeShards(t *testing.T) {
+	shards := []string{"The quick brown fox.", "The lazy dog sleeps.", "A quick brown dog runs."}
+	parts := make([]*Textee, len(shards))
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+			parts[i], _ = NewTexteeWithOptions(Options{SurfaceForms: true}, shard)
+		}(i, shard)
+	}
+	wg.Wait()
+
+	merged := parts[0].Merge(parts[1:]...)
+	whole, err := NewTextee(shards...)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if !merged.Equal(whole) {
+		t.Error("Merge() of the shards is not Equal to parsing them together")
+	}
+	for _, cipher := range Ciphers() {
+		if !reflect.DeepEqual(merged.ScoresFor(cipher), whole.ScoresFor(cipher)) {
+			t.Errorf("Merge() %s scores differ from parsing the shards together", cipher)
+		}
+	}
+	if !sameGematria(merged.InputGematria(), whole.InputGematria()) {
+		t.Errorf("Merge() InputGematria() = %v, want %v", merged.InputGematria(), whole.InputGematria())
+	}
+	if got := len(merged.Sentences()); got != 3 {
+		t.Errorf("Merge() kept %d sentences, want 3", got)
+	}
+	if got := merged.SurfaceForms("the"); got["The"] != 2 {
+		t.Errorf("Merge() SurfaceForms(the) = %v, want the forms of both shards", got)
+	}
+}
+
+func TestTextee_MergeMaxSubstrings(t *testing.T) {
+	opts := Options{MaxSubstrings: 3, MaxNgram: 1}
+	first, err := NewTexteeWithOptions(opts, "red red red fish.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	second, err := NewTexteeWithOptions(opts, "blue blue green.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	merged := first.Merge(second)
+	want := map[string]int{"red": 3, "blue": 2}
+	if got := quantities(merged); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want the lowest counts evicted down to MaxSubstrings: %v", got, want)
+	}
+	if got := merged.Evictions(); got != 2 {
+		t.Errorf("Evictions() = %d, want 2", got)
+	}
+	if _, ok := merged.Gematrias["fish"]; ok {
+		t.Error("Merge() kept the gematria of an evicted substring")
+	}
+}
+
+func TestTextee_Intersect(t *testing.T) {
+	first, err := NewTextee("We shall fight on the beaches. We shall never surrender.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	second, err := NewTexteeWithOptions(Options{SkipGematria: true}, "We shall overcome. We shall overcome someday.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	shared := first.Intersect(second)
+	want := map[string]int{"we": 2, "shall": 2, "we shall": 2}
+	if got := quantities(shared); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+	if len(shared.Gematrias) != len(want) {
+		t.Errorf("Intersect() scored %d substrings, want the %d shared ones reused from the scored side", len(shared.Gematrias), len(want))
+	}
+	gem := first.Gematrias["we shall"]
+	if !slices.Contains(shared.ScoresEnglish[gem.English], "we shall") {
+		t.Errorf("Intersect() ScoresEnglish[%d] = %v, want it to hold we shall", gem.English, shared.ScoresEnglish[gem.English])
+	}
+	if got := first.Intersect(nil); len(got.Substrings) != 0 {
+		t.Errorf("Intersect(nil) = %v, want nothing shared", got.Substrings)
+	}
+}
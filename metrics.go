@@ -0,0 +1,35 @@
+package textee
+
+import "time"
+
+// Metrics describes the work done while constructing a Textee and is passed to Options.OnMetrics
+type Metrics struct {
+	ParseDuration    time.Duration // time spent splitting and counting, including fused scoring
+	GematriaDuration time.Duration // time spent in CalculateGematria
+	Sentences        int           // sentences counted
+	Substrings       int           // unique substrings counted
+	Goroutines       int           // goroutines spawned for counting and scoring
+	Evictions        int           // substrings evicted to respect Options.MaxSubstrings
+}
+
+// startMetrics begins collecting Metrics for tt when Options.OnMetrics is set
+func (tt *Textee) startMetrics() {
+	if tt.opts.OnMetrics != nil {
+		tt.metrics = &Metrics{}
+	}
+}
+
+// reportMetrics passes the collected Metrics to Options.OnMetrics and stops collecting
+func (tt *Textee) reportMetrics() {
+	tt.mu.Lock()
+	metrics := tt.metrics
+	tt.metrics = nil
+	if metrics != nil {
+		metrics.Substrings = len(tt.Substrings)
+		metrics.Evictions = tt.evictions
+	}
+	tt.mu.Unlock()
+	if metrics != nil {
+		tt.opts.OnMetrics(*metrics)
+	}
+}
@@ -0,0 +1,37 @@
+package textee
+
+import "testing"
+
+func TestOptions_OnMetrics(t *testing.T) {
+	var got []Metrics
+	opts := Options{Workers: 2, OnMetrics: func(m Metrics) { got = append(got, m) }}
+	tt, err := NewTexteeWithOptions(opts, "The first sentence. The second one!")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("OnMetrics called %d times, want once", len(got))
+	}
+	m := got[0]
+	if m.Sentences != 2 || m.Substrings != len(tt.Substrings) || m.Goroutines != 4 {
+		t.Errorf("Metrics = %+v, want 2 sentences, %d substrings and 4 goroutines", m, len(tt.Substrings))
+	}
+	if m.ParseDuration <= 0 || m.GematriaDuration <= 0 {
+		t.Errorf("Metrics = %+v, want both durations measured", m)
+	}
+
+	if _, err := tt.CalculateGematria(); err != nil {
+		t.Fatalf("CalculateGematria() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("OnMetrics called %d times, want it limited to the constructor", len(got))
+	}
+
+	got = nil
+	if _, err := NewTexteeWithOptions(Options{SkipGematria: true, OnMetrics: opts.OnMetrics}, "Just counting."); err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if len(got) != 1 || got[0].GematriaDuration != 0 {
+		t.Errorf("Metrics with SkipGematria = %+v, want one report without gematria time", got)
+	}
+}
@@ -0,0 +1,71 @@
+package textee
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxNumberWord is the largest integer Options.NumberWords spells out, larger numbers are left as digits
+const MaxNumberWord = 999_999_999_999
+
+var (
+	numberOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	numberTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	numberScales = []string{"", "thousand", "million", "billion"}
+)
+
+// numberWords spells n out in English words, such as 1042 as "one thousand forty two", without "and" or hyphens
+func numberWords(n uint64) string {
+	if n < 20 {
+		return numberOnes[n]
+	}
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		if group := n % 1000; group > 0 {
+			words := hundredWords(group)
+			if numberScales[scale] != "" {
+				words += " " + numberScales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		n /= 1000
+	}
+	return strings.Join(groups, " ")
+}
+
+// hundredWords spells out 1 through 999
+func hundredWords(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, numberOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20:
+		words = append(words, numberTens[n/10])
+		if n%10 > 0 {
+			words = append(words, numberOnes[n%10])
+		}
+	case n > 0:
+		words = append(words, numberOnes[n])
+	}
+	return strings.Join(words, " ")
+}
+
+// foldNumber returns the spelled out words of token when it is a plain integer no larger than MaxNumberWord,
+// ignoring surrounding punctuation, and nil otherwise. Signs, separators, decimals and ordinals such as "-3",
+// "1,000", "3.5" or "1st" are malformed for this purpose and are left untouched.
+func foldNumber(token string) []string {
+	digits := strings.TrimFunc(token, func(r rune) bool {
+		return strings.ContainsRune(`.,!?;:"'()[]`, r)
+	})
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return nil
+	}
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || n > MaxNumberWord {
+		return nil
+	}
+	return strings.Fields(numberWords(n))
+}
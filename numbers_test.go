@@ -0,0 +1,64 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNumberWords(t *testing.T) {
+	tests := map[uint64]string{
+		0:               "zero",
+		7:               "seven",
+		19:              "nineteen",
+		21:              "twenty one",
+		100:             "one hundred",
+		1042:            "one thousand forty two",
+		2_000_015:       "two million fifteen",
+		MaxNumberWord:   "nine hundred ninety nine billion nine hundred ninety nine million nine hundred ninety nine thousand nine hundred ninety nine",
+		300_000_000_000: "three hundred billion",
+	}
+	for n, want := range tests {
+		if got := numberWords(n); got != want {
+			t.Errorf("numberWords(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestFoldNumber(t *testing.T) {
+	tests := map[string][]string{
+		"7":             {"seven"},
+		"(12),":         {"twelve"},
+		"3.5":           nil,
+		"1,000":         nil,
+		"1st":           nil,
+		"-3":            nil,
+		"seven":         nil,
+		"1000000000000": nil,
+	}
+	for token, want := range tests {
+		if got := foldNumber(token); !reflect.DeepEqual(got, want) {
+			t.Errorf("foldNumber(%q) = %q, want %q", token, got, want)
+		}
+	}
+}
+
+func TestOptions_NumberWords(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{NumberWords: true}, "I saw 7 cats and seven dogs.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, ok := tt.Substrings["7"]; ok {
+		t.Error("Substrings should not contain 7 when NumberWords is set")
+	}
+	if got := tt.Substrings["seven"].Load(); got != 2 {
+		t.Errorf("Substrings[seven] = %d, want 2", got)
+	}
+
+	plain, err := NewTextee("I saw 7 cats and seven dogs.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, ok := plain.Substrings["7"]; !ok {
+		t.Error("NumberWords should be opt-in")
+	}
+}
@@ -0,0 +1,290 @@
+package textee
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// Options configures how a Textee parses and scores its input, the zero value matches NewTextee
+type Options struct {
+	// GematriaFallback scores a substring that gematria.NewGematria rejects, when nil CalculateGematria errors
+	GematriaFallback func(string) gematria.Gematria
+
+	// FusedGematria scores each unique substring as ParseString first counts it, leaving NewTextee a single
+	// pass instead of a separate CalculateGematria over the finished map. The results are identical.
+	FusedGematria bool
+
+	// Ciphers registers additional named ciphers, each scoring a cleaned, lowercased substring, whose buckets are
+	// kept in CustomScores and can be queried by passing Cipher(name) wherever a Cipher is accepted. Names that
+	// match a built-in Cipher are ignored so the built-ins cannot be replaced.
+	Ciphers map[string]func(string) uint64
+
+	// OverflowThreshold is the cipher value above which CheckOverflow flags a substring, 0 uses
+	// DefaultOverflowThreshold
+	OverflowThreshold uint64
+
+	// SkipGematria makes NewTextee count substrings without scoring them, leaving Gematrias and every Scores*
+	// map empty for plain frequency analysis. It takes precedence over FusedGematria, while the input's own
+	// Gematria is still calculated and CalculateGematria can be called later.
+	SkipGematria bool
+
+	// StrictCleaning makes ParseString return the joined errors when any n-gram fails to clean instead of
+	// skipping it and reporting it through CleanFailures
+	StrictCleaning bool
+
+	// StrictGematria makes CalculateGematria return an error when any substring fails to score instead of
+	// skipping it and reporting it through FailedSubstrings
+	StrictGematria bool
+
+	// OnMetrics receives the Metrics of each constructor call once it succeeds, nil skips collecting them
+	OnMetrics func(Metrics)
+
+	// MinNgram is the fewest words a counted substring may hold, 0 uses 1
+	MinNgram int
+
+	// MaxNgram is the most words a counted substring may hold, 0 uses 3
+	MaxNgram int
+
+	// Tokenizer splits each sentence into words, nil uses FieldsTokenizer
+	Tokenizer Tokenizer
+
+	// Stemmer rewrites each cleaned, lowercased word before n-grams are counted, SuffixStemmer is provided.
+	// Gematria is calculated on the stemmed form, so stemming intentionally changes the resulting scores.
+	Stemmer func(string) string
+
+	// Casing lowercases words with locale specific rules such as unicode.TurkishCase before they are cleaned,
+	// nil keeps plain strings.ToLower. Cleaning keeps only ASCII letters and digits, so the lowered letters that
+	// have an ASCII form are folded to it first: under unicode.TurkishCase both "İ" and "I", which lowers to the
+	// dotless "ı", are kept as "i", so "ISTANBUL" counts as "istanbul".
+	Casing unicode.SpecialCase
+
+	// CaseSensitive keeps the case of every word so "Word" and "word" are counted apart, Casing is then ignored.
+	// Gematria is unaffected since every cipher scores letters regardless of case.
+	CaseSensitive bool
+
+	// CrossSentence builds n-grams over the whole input as one stream of words so they may span sentences.
+	// Sentences are still split for the sentence-level methods, so a period after an abbreviation such as
+	// "Mr." only affects those methods rather than cutting n-grams short.
+	CrossSentence bool
+
+	// KeepWhitespace skips the whitespace normalization that otherwise collapses every run of Unicode
+	// whitespace into a single space before sentence splitting. Line breaks are kept through normalization
+	// when NewlineSentences or SentenceDelimiter is set, or when the input has no sentence terminators and so
+	// is split into sentences by line.
+	KeepWhitespace bool
+
+	// StripHTML removes markup before sentence splitting, dropping comments and the contents of script and
+	// style elements entirely rather than counting them as words
+	StripHTML bool
+
+	// StripMarkdown removes Markdown formatting before StripHTML and sentence splitting. Fenced code blocks,
+	// inline code and URLs are dropped from analysis while link and image text is kept.
+	StripMarkdown bool
+
+	// DecodeEntities decodes HTML entities such as &amp; before sentence splitting
+	DecodeEntities bool
+
+	// LocalCounting has each sentence goroutine count into a plain map[string]int and merge it into
+	// Substrings under one lock when the sentence is done, instead of locking around every increment. The
+	// results and every accessor are identical, but BenchmarkParseString_LocalCounting measures it about a
+	// quarter slower than the shared counters while allocating nearly twice the memory, so leave it off
+	// unless profiling shows lock contention on Substrings.
+	LocalCounting bool
+
+	// Workers bounds the goroutines CalculateGematria scores substrings with, 0 uses runtime.NumCPU()
+	Workers int
+
+	// NewlineSentences ends a sentence at every line break as well as at the usual .!? terminators
+	NewlineSentences bool
+
+	// SentenceDelimiter replaces the built-in sentence splitting, the text between its matches are sentences
+	SentenceDelimiter *regexp.Regexp
+
+	// ExpandContractions rewrites contractions such as "don't" into "do not" before tokenizing and strips the
+	// 's from other possessives, so "teacher's" counts as "teacher". The expanded words are what get counted
+	// and scored, so "don't" contributes the gematria of "do not" rather than that of "dont".
+	ExpandContractions bool
+
+	// Contractions adds to or overrides DefaultContractions, keyed by the lowercase contraction
+	Contractions map[string]string
+
+	// NumberWords spells integer tokens out in English before n-grams are counted, so "7" and "seven" count
+	// and score as the same word. Numbers of several words such as "21" become "twenty one" and add n-grams of
+	// their own. Integers above MaxNumberWord and malformed numbers like "3.5", "1,000" or "1st" stay as they are.
+	NumberWords bool
+
+	// CanonicalStopwords counts each n-gram under its words with Stopwords removed, merging variants such as
+	// "king of england" into "king england". A trigram can therefore add to a bigram key, while windows that
+	// start or end with a stopword are skipped so "king of" does not add to "king" and stopwords alone are never
+	// counted. Gematria is calculated on the canonical key.
+	CanonicalStopwords bool
+
+	// Stopwords are the words CanonicalStopwords removes, empty uses DefaultStopwords
+	Stopwords []string
+
+	// SurfaceForms records the cleaned text, in its original capitalization, that each substring was counted
+	// from, which SurfaceForms on the Textee reports
+	SurfaceForms bool
+
+	// DominantSurface tracks surface forms like SurfaceForms and reports each substring in SortedSubstrings,
+	// All, Stream and String under its most common original capitalization, such as "NASA" rather than "nasa".
+	// Counting and every map key stay lowercased so the forms still aggregate.
+	DominantSurface bool
+
+	// DropNumeric skips substrings whose cleaned form is only digits and spaces, such as "2024" or "12 34",
+	// while mixed ones like "covid19" or "page 12" are still counted
+	DropNumeric bool
+
+	// Redact lists terms, matched case-insensitively as whole words, that are replaced by RedactPlaceholder in
+	// the raw text before anything else is derived from it, so they never reach Input, Substrings, Gematrias or
+	// any Scores* map. The placeholder itself is never counted and n-grams do not span it.
+	Redact []string
+
+	// RedactPattern redacts every match of the expression in the same way as Redact
+	RedactPattern *regexp.Regexp
+
+	// RedactPlaceholder replaces redacted terms, empty uses DefaultRedactPlaceholder
+	RedactPlaceholder string
+
+	// MaxInputBytes rejects input longer than this many bytes with ErrInputTooLarge before any parsing
+	// begins, 0 leaves the input size unlimited
+	MaxInputBytes int
+
+	// MaxPairWords caps how many distinct words per sentence CoOccurrence and AdjacencyMatrix pair up,
+	// 0 pairs every word
+	MaxPairWords int
+
+	// MaxAdjacencyWords bounds the vocabulary of AdjacencyMatrix, whose matrix holds len(words)² ints, to the
+	// words found in the most sentences, 0 uses DefaultMaxAdjacencyWords
+	MaxAdjacencyWords int
+
+	// MaxSubstrings caps how many distinct substrings are tracked, 0 tracks every one. Once the cap is reached
+	// the lowest-count substrings are evicted, so the counts of rare substrings become approximate and
+	// Evictions reports how many were dropped.
+	MaxSubstrings int
+
+	// MinCount drops every substring counted fewer times once ParseString or ParseReader finishes, before any
+	// scoring, 0 keeps them all. AppendString and ParseTokens add to existing counts and leave them alone.
+	MinCount int
+
+	// MinWordLength skips n-grams holding any cleaned word shorter than this many characters, such as "a" or
+	// "a tale" with 2, 0 keeps every word
+	MinWordLength int
+
+	scorer  func(string) (gematria.Gematria, error) // replaces gematria.NewGematria in tests, nil uses it
+	cleaner func(string) (string, error)            // replaces cleanSubstring in tests, nil uses it
+}
+
+// ngramRange returns the configured MinNgram and MaxNgram with the zero values replaced by their defaults
+func (o Options) ngramRange() (int, int) {
+	minimum, maximum := o.MinNgram, o.MaxNgram
+	if minimum == 0 {
+		minimum = 1
+	}
+	if maximum == 0 {
+		maximum = 3
+	}
+	return minimum, maximum
+}
+
+// validate rejects negative limits and an n-gram range whose minimum exceeds its maximum
+func (o Options) validate() error {
+	minimum, maximum := o.ngramRange()
+	switch {
+	case o.MinNgram < 0 || o.MaxNgram < 0:
+		return errors.Join(ErrInvalidOptions, errors.New("n-gram sizes must not be negative"))
+	case minimum > maximum:
+		return errors.Join(ErrInvalidOptions, fmt.Errorf("MinNgram %d exceeds MaxNgram %d", minimum, maximum))
+	case o.Workers < 0 || o.MaxInputBytes < 0 || o.MaxPairWords < 0 || o.MaxSubstrings < 0 ||
+		o.MaxAdjacencyWords < 0 || o.MinCount < 0 || o.MinWordLength < 0:
+		return errors.Join(ErrInvalidOptions, errors.New("limits and minimums must not be negative"))
+	}
+	return nil
+}
+
+// scoreGematria calculates the gematria of a substring with gematria.NewGematria unless a scorer is set. Stored
+// keys are already cleaned and trimmed, so the trimming and character filtering gematria.NewGematria applies
+// leave them unchanged and each key is scored exactly as stored.
+func (o Options) scoreGematria(substring string) (gematria.Gematria, error) {
+	if o.scorer != nil {
+		return o.scorer(substring)
+	}
+	return gematria.NewGematria(substring)
+}
+
+// clean reduces word to A-Za-z0-9\s with cleanSubstring unless a cleaner is set
+func (o Options) clean(word string) (string, error) {
+	if o.cleaner != nil {
+		return o.cleaner(word)
+	}
+	return cleanSubstring(word)
+}
+
+// normalizeSubstring cleans, lowercases and trims s into the form substrings are stored under
+func (o Options) normalizeSubstring(s string) (string, error) {
+	cleaned, err := o.clean(s)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.ToLower(cleaned)), nil
+}
+
+// fused reports whether ParseString scores substrings as it counts them
+func (o Options) fused() bool {
+	return o.FusedGematria && !o.SkipGematria
+}
+
+// Option sets one field of Options, see New
+type Option func(*Options)
+
+// New parses and scores input like NewTexteeWithOptions configured by opts, applied in order to the zero Options
+func New(input string, opts ...Option) (*Textee, error) {
+	var options Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return NewTexteeWithOptions(options, input)
+}
+
+// WithMinNgram sets Options.MinNgram
+func WithMinNgram(n int) Option {
+	return func(o *Options) { o.MinNgram = n }
+}
+
+// WithMaxNgram sets Options.MaxNgram
+func WithMaxNgram(n int) Option {
+	return func(o *Options) { o.MaxNgram = n }
+}
+
+// WithoutGematria sets Options.SkipGematria so substrings are counted but never scored
+func WithoutGematria() Option {
+	return func(o *Options) { o.SkipGematria = true }
+}
+
+// WithCaseSensitive sets Options.CaseSensitive
+func WithCaseSensitive() Option {
+	return func(o *Options) { o.CaseSensitive = true }
+}
+
+// WithMinCount sets Options.MinCount
+func WithMinCount(n int) Option {
+	return func(o *Options) { o.MinCount = n }
+}
+
+// WithMinWordLength sets Options.MinWordLength
+func WithMinWordLength(n int) Option {
+	return func(o *Options) { o.MinWordLength = n }
+}
+
+// WithStopwords sets Options.CanonicalStopwords using list, or DefaultStopwords when list is empty
+func WithStopwords(list ...string) Option {
+	return func(o *Options) {
+		o.CanonicalStopwords = true
+		o.Stopwords = list
+	}
+}
@@ -0,0 +1,208 @@
+package textee
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// failGematriaFor returns an Options scorer that rejects substring and scores everything else as usual
+func failGematriaFor(substring string) func(string) (gematria.Gematria, error) {
+	return func(s string) (gematria.Gematria, error) {
+		if s == substring {
+			return gematria.Gematria{}, errors.New("unscorable")
+		}
+		return gematria.NewGematria(s)
+	}
+}
+
+func TestOptions_GematriaFallback(t *testing.T) {
+	if _, err := NewTexteeWithOptions(Options{StrictGematria: true, scorer: failGematriaFor("bad")}, "good bad"); !errors.Is(err, ErrGematriaParse) {
+		t.Errorf("NewTexteeWithOptions() error = %v, want %v", err, ErrGematriaParse)
+	}
+
+	fallback := gematria.Gematria{English: 7, Simple: 7}
+	tt, err := NewTexteeWithOptions(Options{
+		GematriaFallback: func(string) gematria.Gematria { return fallback },
+		StrictGematria:   true,
+		scorer:           failGematriaFor("bad"),
+	}, "good bad")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.Gematrias["bad"]; !sameGematria(got, fallback) {
+		t.Errorf("Gematrias[bad] = %v, want %v", got, fallback)
+	}
+	if got := tt.ScoresEnglish[7]; len(got) != 1 || got[0] != "bad" {
+		t.Errorf("ScoresEnglish[7] = %v, want [bad]", got)
+	}
+}
+
+func TestOptions_CrossSentence(t *testing.T) {
+	const input = "The end. A start."
+	tt, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, ok := tt.Substrings["end a"]; ok {
+		t.Error("Substrings should not span sentences by default")
+	}
+	crossed, err := NewTexteeWithOptions(Options{CrossSentence: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"end a", "the end a", "end a start"} {
+		if _, ok := crossed.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing with CrossSentence", substring)
+		}
+	}
+	if got := len(crossed.sentences); got != 2 {
+		t.Errorf("CrossSentence kept %d sentences, want 2", got)
+	}
+}
+
+func TestOptions_MaxInputBytes(t *testing.T) {
+	opts := Options{MaxInputBytes: 10}
+	if _, err := NewTexteeWithOptions(opts, "hello", "wor"); err != nil {
+		t.Errorf("NewTexteeWithOptions() at the limit error = %v", err)
+	}
+	if _, err := NewTexteeWithOptions(opts, "hello", "world"); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("NewTexteeWithOptions() error = %v, want %v", err, ErrInputTooLarge)
+	}
+	tt, err := NewTexteeWithOptions(opts, "short")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, err := tt.ParseString("this is far too long"); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("ParseString() error = %v, want %v", err, ErrInputTooLarge)
+	}
+}
+
+func TestOptions_DropNumeric(t *testing.T) {
+	const input = "In 2024 covid19 spread on page 12 34."
+	tt, err := NewTexteeWithOptions(Options{DropNumeric: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"2024", "12", "12 34"} {
+		if _, ok := tt.Substrings[substring]; ok {
+			t.Errorf("Substrings[%q] should be dropped", substring)
+		}
+	}
+	for _, substring := range []string{"covid19", "page 12", "in 2024"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+
+	plain, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, ok := plain.Substrings["2024"]; !ok {
+		t.Error("numeric substrings should be kept by default")
+	}
+}
+
+func TestOptions_Casing(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{Casing: unicode.TurkishCase}, "İZMİR")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, ok := tt.Substrings["izmir"]; !ok {
+		t.Errorf("Substrings = %v, want izmir with Turkish casing", tt.Substrings)
+	}
+	if gem, err := tt.GematriaOf("İZMİR"); err != nil || !sameGematria(gem, tt.Gematrias["izmir"]) {
+		t.Errorf("GematriaOf() = %v, %v, want the izmir scores", gem, err)
+	}
+
+	dotless, err := NewTexteeWithOptions(Options{Casing: unicode.TurkishCase}, "ISTANBUL IRAN")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"istanbul", "iran", "istanbul iran"} {
+		if _, ok := dotless.Substrings[substring]; !ok {
+			t.Errorf("Substrings = %v, want %q with the dotless i folded to ASCII", dotless.Substrings, substring)
+		}
+	}
+
+	plain, err := NewTextee("İZMİR")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, ok := plain.Substrings["zmr"]; !ok {
+		t.Errorf("Substrings = %v, want zmr without Turkish casing", plain.Substrings)
+	}
+}
+
+func TestOptions_NgramRange(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{MinNgram: 2, MaxNgram: 4}, "one two three four five.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"one two", "one two three four", "two three four five"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+	for _, substring := range []string{"one", "five", "one two three four five"} {
+		if _, ok := tt.Substrings[substring]; ok {
+			t.Errorf("Substrings[%q] is outside the n-gram range", substring)
+		}
+	}
+
+	for _, opts := range []Options{{MinNgram: 4}, {MinNgram: 3, MaxNgram: 2}, {MaxNgram: -1}, {Workers: -1}, {MaxAdjacencyWords: -1}} {
+		if _, err := NewTexteeWithOptions(opts, "one two"); !errors.Is(err, ErrInvalidOptions) {
+			t.Errorf("NewTexteeWithOptions(%+v) error = %v, want %v", opts, err, ErrInvalidOptions)
+		}
+	}
+}
+
+func TestOptions_SkipGematria(t *testing.T) {
+	for _, opts := range []Options{{SkipGematria: true}, {SkipGematria: true, FusedGematria: true}} {
+		tt, err := NewTexteeWithOptions(opts, "three six. three.")
+		if err != nil {
+			t.Fatalf("NewTexteeWithOptions() error = %v", err)
+		}
+		if tt.Substrings["three"].Load() != 2 {
+			t.Errorf("Substrings[three] = %d, want 2", tt.Substrings["three"].Load())
+		}
+		if len(tt.Gematrias) != 0 || len(tt.ScoresEnglish) != 0 || len(tt.ScoresEights) != 0 {
+			t.Errorf("SkipGematria with %+v should leave Gematrias and Scores empty", opts)
+		}
+		if output := tt.String(); !strings.Contains(output, "\"three\": 2\n") || strings.Contains(output, "[English") {
+			t.Errorf("String() = %q, want the plain format", output)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	tt, err := New("The King met the king of Spain.", WithMaxNgram(2), WithCaseSensitive(), WithoutGematria())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if tt.Count("King") != 1 || tt.Count("king") != 1 {
+		t.Errorf("Count(King) = %d, Count(king) = %d, want them counted apart", tt.Count("King"), tt.Count("king"))
+	}
+	if _, ok := tt.Substrings["king of Spain"]; ok {
+		t.Error("Substrings should not hold trigrams with WithMaxNgram(2)")
+	}
+	if len(tt.Gematrias) != 0 {
+		t.Errorf("len(Gematrias) = %d with WithoutGematria, want 0", len(tt.Gematrias))
+	}
+
+	stopped, err := New("The king of Spain.", WithStopwords(), WithMinNgram(2), WithCaseSensitive())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if stopped.Count("king Spain") != 1 || stopped.Count("The king") != 0 {
+		t.Errorf("Substrings = %v, want stopwords dropped regardless of case", stopped.SortedSubstrings())
+	}
+
+	if _, err := New("text", WithMinNgram(3), WithMaxNgram(2)); !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("New() error = %v, want %v", err, ErrInvalidOptions)
+	}
+}
@@ -0,0 +1,51 @@
+package textee
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultOverflowThreshold is the cipher value above which CheckOverflow flags a substring when
+// Options.OverflowThreshold is 0, adding any two values above it wraps a uint64
+const DefaultOverflowThreshold uint64 = math.MaxUint64 / 2
+
+// overflowThreshold returns Options.OverflowThreshold, or DefaultOverflowThreshold when it is 0
+func (o Options) overflowThreshold() uint64 {
+	if o.OverflowThreshold == 0 {
+		return DefaultOverflowThreshold
+	}
+	return o.OverflowThreshold
+}
+
+// CheckOverflow returns the sorted substrings with a score above Options.OverflowThreshold under any built-in
+// cipher or any of Options.Ciphers, whose values are unsafe to sum or average without wrapping around
+func (tt *Textee) CheckOverflow() []string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	threshold := tt.opts.overflowThreshold()
+	flagged := make(map[string]struct{})
+	for substring, gem := range tt.Gematrias {
+		for _, cipher := range Ciphers() {
+			if cipher.Value(gem) > threshold {
+				flagged[substring] = struct{}{}
+				break
+			}
+		}
+	}
+	for _, scores := range tt.CustomScores {
+		for value, bucket := range scores {
+			if value <= threshold {
+				continue
+			}
+			for _, substring := range bucket {
+				flagged[substring] = struct{}{}
+			}
+		}
+	}
+	substrings := make([]string, 0, len(flagged))
+	for substring := range flagged {
+		substrings = append(substrings, substring)
+	}
+	sort.Strings(substrings)
+	return substrings
+}
@@ -0,0 +1,35 @@
+package textee
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+func TestTextee_CheckOverflow(t *testing.T) {
+	tt, err := NewTextee("ordinary words")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got := tt.CheckOverflow(); len(got) != 0 {
+		t.Errorf("CheckOverflow() = %v, want none", got)
+	}
+	tt.Gematrias["huge"] = gematria.Gematria{Mystery: math.MaxUint64 - 1}
+	if got, want := tt.CheckOverflow(), []string{"huge"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckOverflow() = %v, want %v", got, want)
+	}
+
+	opts := Options{
+		OverflowThreshold: 1 << 20,
+		Ciphers:           map[string]func(string) uint64{"wide": func(s string) uint64 { return uint64(len(s)) << 18 }},
+	}
+	custom, err := NewTexteeWithOptions(opts, "ab abc")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got, want := custom.CheckOverflow(), []string{"ab abc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckOverflow() with a custom cipher = %v, want %v", got, want)
+	}
+}
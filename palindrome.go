@@ -0,0 +1,36 @@
+package textee
+
+import (
+	"sort"
+	"strings"
+)
+
+// Palindromes returns the sorted substrings that read the same forwards and backwards once their spaces are
+// removed and their letters lowercased, so phrases such as "a toyota" count and so does "Anna" under
+// Options.CaseSensitive. Single characters are not palindromes here, the letters and digits left after cleaning
+// must number at least two.
+func (tt *Textee) Palindromes() []string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	var palindromes []string
+	for substring := range tt.Substrings {
+		if isPalindrome(strings.ToLower(strings.ReplaceAll(substring, " ", ""))) {
+			palindromes = append(palindromes, substring)
+		}
+	}
+	sort.Strings(palindromes)
+	return palindromes
+}
+
+// isPalindrome reports whether s has at least two bytes and mirrors itself, substrings are ASCII once cleaned
+func isPalindrome(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		if s[i] != s[j] {
+			return false
+		}
+	}
+	return true
+}
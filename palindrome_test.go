@@ -0,0 +1,26 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextee_Palindromes(t *testing.T) {
+	tt, err := NewTextee("A Toyota. Noon racecar. I saw 1221.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want := []string{"1221", "a toyota", "noon", "racecar"}
+	if got := tt.Palindromes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Palindromes() = %v, want %v", got, want)
+	}
+
+	tt, err = NewTexteeWithOptions(Options{CaseSensitive: true}, "Bob saw Anna.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	want = []string{"Anna", "Bob"}
+	if got := tt.Palindromes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Palindromes() with CaseSensitive = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package textee
+
+import (
+	"encoding/gob"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// Save writes tt to path in the gob format of GobEncode, so LoadTextee can restore it without recalculating any
+// gematria. The file is written to a temporary file beside path and renamed into place, so a failed Save never
+// leaves a truncated file behind. The file keeps the mode of the one it replaces, or gets 0644 when path is new,
+// rather than the 0600 of the temporary file. Failures return ErrWriteFile joined with the underlying error.
+func (tt *Textee) Save(path string) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Join(ErrWriteFile, err)
+	}
+	defer os.Remove(file.Name())
+	if err := gob.NewEncoder(file).Encode(tt); err != nil {
+		_ = file.Close()
+		return errors.Join(ErrWriteFile, err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Join(ErrWriteFile, err)
+	}
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(file.Name(), mode); err != nil {
+		return errors.Join(ErrWriteFile, err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return errors.Join(ErrWriteFile, err)
+	}
+	return nil
+}
+
+// LoadTextee reads a Textee written by Save. A path that cannot be opened returns ErrOpenFile and one that does
+// not hold a saved Textee returns ErrReadInput, each joined with the underlying error. Options are not saved,
+// so the loaded Textee parses with the defaults.
+func LoadTextee(path string) (*Textee, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(ErrOpenFile, err)
+	}
+	defer file.Close()
+	tt := &Textee{}
+	if err := gob.NewDecoder(file).Decode(tt); err != nil {
+		return nil, errors.Join(ErrReadInput, err)
+	}
+	return tt, nil
+}
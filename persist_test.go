@@ -0,0 +1,71 @@
+package textee
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTextee_SaveLoad(t *testing.T) {
+	tt, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "corpus.gob")
+	if err := tt.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := LoadTextee(path)
+	if err != nil {
+		t.Fatalf("LoadTextee() error = %v", err)
+	}
+	if !loaded.Equal(tt) {
+		t.Error("LoadTextee() result is not Equal to the saved Textee")
+	}
+	if !reflect.DeepEqual(loaded.ScoresEnglish, tt.ScoresEnglish) {
+		t.Error("LoadTextee() ScoresEnglish does not match the saved Textee")
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Save() left %d files behind, want only the saved one", len(entries))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0o644 {
+		t.Errorf("Save() new file mode = %v, want %v", info.Mode().Perm(), os.FileMode(0o644))
+	}
+	if err := os.Chmod(path, 0o640); err != nil {
+		t.Fatalf("Chmod() error = %v", err)
+	}
+	if err := tt.Save(path); err != nil {
+		t.Fatalf("Save() over an existing file error = %v", err)
+	}
+	if info, err = os.Stat(path); err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("Save() replaced file mode = %v, want the existing %v", info.Mode().Perm(), os.FileMode(0o640))
+	}
+
+	if _, err := LoadTextee(filepath.Join(t.TempDir(), "missing.gob")); !errors.Is(err, ErrOpenFile) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadTextee() missing file error = %v, want %v wrapping %v", err, ErrOpenFile, fs.ErrNotExist)
+	}
+	garbage := filepath.Join(t.TempDir(), "garbage.gob")
+	if err := os.WriteFile(garbage, []byte("not a textee"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := LoadTextee(garbage); !errors.Is(err, ErrReadInput) {
+		t.Errorf("LoadTextee() garbage error = %v, want %v", err, ErrReadInput)
+	}
+	if err := tt.Save(filepath.Join(t.TempDir(), "missing", "dir.gob")); !errors.Is(err, ErrWriteFile) {
+		t.Errorf("Save() into a missing directory error = %v, want %v", err, ErrWriteFile)
+	}
+}
@@ -0,0 +1,60 @@
+package textee
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	regHTMLScript  = regexp.MustCompile(`(?is)<script\b[^>]*>.*?</script\s*>`)
+	regHTMLStyle   = regexp.MustCompile(`(?is)<style\b[^>]*>.*?</style\s*>`)
+	regHTMLComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+	regHTMLTag     = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// preprocess applies the configured transformations to input before it is split into sentences
+func (tt *Textee) preprocess(input string) string {
+	if tt.opts.StripMarkdown {
+		input = stripMarkdown(input)
+	}
+	if tt.opts.StripHTML {
+		input = stripHTML(input)
+	}
+	if tt.opts.DecodeEntities {
+		input = html.UnescapeString(input)
+	}
+	if tt.opts.ExpandContractions {
+		input = expandContractions(input, tt.opts.Contractions)
+	}
+	if !tt.opts.KeepWhitespace {
+		keepNewlines := tt.opts.NewlineSentences || tt.opts.SentenceDelimiter != nil || !strings.ContainsAny(input, ".!?")
+		input = normalizeWhitespace(input, keepNewlines)
+	}
+	return input
+}
+
+// normalizeWhitespace collapses every run of Unicode whitespace, including non-breaking spaces and tabs, into a
+// single ASCII space and trims the result. With keepNewlines set, line breaks (\n, \r\n or \r) survive as a
+// single \n between the collapsed lines so newline-based sentence splitting still sees them.
+func normalizeWhitespace(input string, keepNewlines bool) string {
+	if !keepNewlines {
+		return strings.Join(strings.Fields(input), " ")
+	}
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+	input = strings.ReplaceAll(input, "\r", "\n")
+	lines := strings.Split(input, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// stripHTML removes comments, script and style elements with their contents, then replaces every other tag
+// with a space so the words either side of it stay apart
+func stripHTML(input string) string {
+	input = regHTMLComment.ReplaceAllString(input, " ")
+	input = regHTMLScript.ReplaceAllString(input, " ")
+	input = regHTMLStyle.ReplaceAllString(input, " ")
+	return regHTMLTag.ReplaceAllString(input, " ")
+}
@@ -0,0 +1,49 @@
+package textee
+
+import "testing"
+
+func TestOptions_StripHTML(t *testing.T) {
+	const input = `<div class="intro"><p>Fish &amp; chips, salt and pepper.</p><script>var hidden = 1;</script>` +
+		`<style>.x { color: red; }</style><!-- note --><a href="/more">Read more.</a></div>`
+	tt, err := NewTexteeWithOptions(Options{StripHTML: true, DecodeEntities: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, absent := range []string{"div", "class", "href", "hidden", "var", "color", "note", "amp"} {
+		if _, ok := tt.Substrings[absent]; ok {
+			t.Errorf("Substrings should not contain %q", absent)
+		}
+	}
+	for _, present := range []string{"fish", "salt and pepper", "read more"} {
+		if _, ok := tt.Substrings[present]; !ok {
+			t.Errorf("Substrings[%q] missing", present)
+		}
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	const input = "  one\u00a0two\t\tthree\r\nfour  \n\n five "
+	if got, want := normalizeWhitespace(input, false), "one two three four five"; got != want {
+		t.Errorf("normalizeWhitespace() = %q, want %q", got, want)
+	}
+	if got, want := normalizeWhitespace(input, true), "one two three\nfour\n\nfive"; got != want {
+		t.Errorf("normalizeWhitespace(keepNewlines) = %q, want %q", got, want)
+	}
+}
+
+func TestOptions_WhitespaceNormalization(t *testing.T) {
+	tt, err := NewTextee("one\u00a0two.\tThree\r\nfour.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got := tt.Sentences(); len(got) != 2 || got[0] != "one two." || got[1] != "Three four." {
+		t.Errorf("Sentences() = %q, want normalized whitespace", got)
+	}
+	lines, err := NewTexteeWithOptions(Options{NewlineSentences: true}, "red\r\nblue")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, ok := lines.Substrings["red blue"]; ok {
+		t.Error("normalization merged words separated only by a newline")
+	}
+}
@@ -0,0 +1,214 @@
+package textee
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// NewTexteeFromReader streams r through ParseReader and scores it like NewTextee, so the text itself is never held
+// whole: only the pending chunk is buffered, sentences are not kept and Input is left empty. Memory still grows
+// with the number of distinct substrings counted. Gematria is summed chunk by chunk.
+// Reading nothing but whitespace returns ErrEmptyInput and a failed read returns ErrReadInput joined with the
+// underlying error.
+func NewTexteeFromReader(r io.Reader) (*Textee, error) {
+	return newTexteeFromReader(Options{}, r)
+}
+
+// newTexteeFromReader streams r into a Textee configured by opts, see NewTexteeFromReader
+func newTexteeFromReader(opts Options, r io.Reader) (*Textee, error) {
+	tt := newTextee(opts, "", gematria.Gematria{})
+	tt.startMetrics()
+	blank := true
+	err := tt.parseReader(r, func(chunk string, sentences []string) error {
+		if strings.TrimSpace(chunk) != "" {
+			blank = false
+		}
+		gem, err := tt.sentencesGematria(sentences)
+		if err != nil {
+			return err
+		}
+		tt.mu.Lock()
+		tt.Gematria = addGematria(tt.Gematria, gem)
+		tt.mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if blank {
+		return nil, ErrEmptyInput
+	}
+	if err = tt.calculateGematria(context.Background()); err != nil {
+		return nil, errors.Join(ErrBadParsing, err)
+	}
+	tt.reportMetrics()
+	return tt, nil
+}
+
+// NewTexteeFromFile opens path and parses its contents through NewTexteeFromReader, closing it even when
+// parsing fails. A path that cannot be opened, a nonexistent one included, returns ErrOpenFile and a failed read
+// returns ErrReadInput, each joined with the underlying error so errors.Is still matches fs.ErrNotExist.
+func NewTexteeFromFile(path string) (*Textee, error) {
+	return newTexteeFromFile(Options{}, path)
+}
+
+// newTexteeFromFile opens path and streams it into a Textee configured by opts, see NewTexteeFromFile
+func newTexteeFromFile(opts Options, path string) (*Textee, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(ErrOpenFile, err)
+	}
+	defer file.Close()
+	return newTexteeFromReader(opts, file)
+}
+
+// readChunkSize is how many bytes ParseReader buffers before looking for a sentence boundary to cut at
+const readChunkSize = 64 << 10
+
+// maxPendingChunks bounds how many chunks ParseReader holds while waiting for a sentence boundary before it cuts
+// at the last whitespace, or at the bound itself when there is none
+const maxPendingChunks = 4
+
+// ParseReader counts the n-grams of everything read from r into a fresh Substrings map like ParseString, but
+// parses the text in chunks cut at sentence boundaries so only a few multiples of readChunkSize bytes are held at
+// once. A sentence longer than maxPendingChunks chunks is cut at its last whitespace instead, and text without
+// any whitespace is cut at the bound itself. Preprocessing and Options.CrossSentence apply within each chunk, and
+// Input and Gematria are left untouched. Sentences are counted but not kept, so Sentences, SentenceScores,
+// SentenceTF, CoOccurrence and AdjacencyMatrix report nothing for text parsed this way. A failed read returns ErrReadInput joined with the underlying error
+// after counting what was read before it.
+func (tt *Textee) ParseReader(r io.Reader) (*Textee, error) {
+	return tt, tt.parseReader(r, nil)
+}
+
+// parseReader does the work of ParseReader, handing every chunk it parses to parsed, when it is not nil, along
+// with the sentences it was split into. An error from parsed stops the parse and is returned as is.
+func (tt *Textee) parseReader(r io.Reader, parsed func(chunk string, sentences []string) error) error {
+	started := tt.resetCounts()
+	state := tt.newParseState()
+	state.discardSentences = true
+	parse := func(chunk []byte) error {
+		text := tt.redact(string(chunk))
+		sentences, err := tt.splitSentences(tt.preprocess(text))
+		if err != nil {
+			return errors.Join(ErrBadParsing, err)
+		}
+		if parsed != nil {
+			if err := parsed(text, sentences); err != nil {
+				return err
+			}
+		}
+		tt.countSentences(sentences, state)
+		return nil
+	}
+
+	buf := make([]byte, readChunkSize)
+	var chunks chunker
+	total := 0
+	for {
+		n, readErr := r.Read(buf)
+		chunks.write(buf[:n])
+		total += n
+		if tt.opts.MaxInputBytes > 0 && total > tt.opts.MaxInputBytes {
+			return ErrInputTooLarge
+		}
+		if readErr != nil {
+			if err := parse(chunks.pending); err != nil {
+				return err
+			}
+			if err := tt.finishParse(state, started); err != nil {
+				return err
+			}
+			if !errors.Is(readErr, io.EOF) {
+				return errors.Join(ErrReadInput, readErr)
+			}
+			return nil
+		}
+		if chunk := chunks.next(); chunk != nil {
+			if err := parse(chunk); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// chunker buffers text read by ParseReader and hands it back in pieces that end on a sentence boundary. Every
+// byte is scanned once, as it is written, so a long sentence costs no more than a short one.
+type chunker struct {
+	pending  []byte
+	scanned  int // bytes of pending already scanned for boundaries
+	sentence int // end of the last complete sentence in pending, 0 when there is none
+	space    int // end of the last whitespace in pending, 0 when there is none
+}
+
+// write appends p to the pending text and records the sentence and whitespace boundaries it holds
+func (c *chunker) write(p []byte) {
+	c.pending = append(c.pending, p...)
+	for i := c.scanned; i < len(c.pending); i++ {
+		if !isSpaceByte(c.pending[i]) {
+			continue
+		}
+		c.space = i + 1
+		if endsSentence(c.pending[:i]) {
+			c.sentence = i + 1
+		}
+	}
+	c.scanned = len(c.pending)
+}
+
+// next removes and returns the pending text up to its last sentence boundary once at least readChunkSize bytes
+// are pending. Past maxPendingChunks chunks it cuts at the last whitespace, or at the bound on a rune boundary
+// when there is none. It returns nil while it should keep buffering.
+func (c *chunker) next() []byte {
+	if len(c.pending) < readChunkSize {
+		return nil
+	}
+	cut := c.sentence
+	if limit := maxPendingChunks * readChunkSize; cut == 0 && len(c.pending) > limit {
+		if cut = c.space; cut == 0 {
+			cut = limit
+			for i := limit; i > limit-utf8.UTFMax; i-- {
+				if utf8.RuneStart(c.pending[i]) {
+					cut = i
+					break
+				}
+			}
+		}
+	}
+	if cut == 0 {
+		return nil
+	}
+	chunk := c.pending[:cut]
+	c.pending = append([]byte(nil), c.pending[cut:]...)
+	c.scanned -= cut
+	c.sentence = 0
+	c.space = max(c.space-cut, 0)
+	return chunk
+}
+
+// isSpaceByte reports whether b is whitespace as \s matches it in regFindSentences
+func isSpaceByte(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\f' || b == '\r'
+}
+
+// endsSentence reports whether text ends with a sentence terminator, optionally followed by closing quotes,
+// parentheses or brackets, the way regFindSentences ends a sentence before whitespace
+func endsSentence(text []byte) bool {
+	for len(text) > 0 {
+		r, size := utf8.DecodeLastRune(text)
+		switch {
+		case r == '.' || r == '!' || r == '?':
+			return true
+		case strings.ContainsRune(`)]}"'”’»`, r):
+			text = text[:len(text)-size]
+		default:
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package textee
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+)
+
+func TestNewTexteeFromFile(t *testing.T) {
+	const content = "All right, move now from this area. All right, I will wait."
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	got, err := NewTexteeFromFile(path)
+	if err != nil {
+		t.Fatalf("NewTexteeFromFile() error = %v", err)
+	}
+	want, err := NewTextee(content)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if len(got.Substrings) != len(want.Substrings) {
+		t.Fatalf("NewTexteeFromFile() has %d substrings, want %d", len(got.Substrings), len(want.Substrings))
+	}
+	for substring, quantity := range want.Substrings {
+		if count, ok := got.Substrings[substring]; !ok || count.Load() != quantity.Load() {
+			t.Errorf("Substrings[%q] does not match the directly parsed content", substring)
+		}
+	}
+
+	_, err = NewTexteeFromFile(filepath.Join(t.TempDir(), "missing.txt"))
+	if !errors.Is(err, ErrOpenFile) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("NewTexteeFromFile() error = %v, want %v wrapping %v", err, ErrOpenFile, fs.ErrNotExist)
+	}
+
+	_, err = NewTexteeFromFile(t.TempDir())
+	if !errors.Is(err, ErrReadInput) || errors.Is(err, ErrBadParsing) {
+		t.Errorf("NewTexteeFromFile() on a directory error = %v, want %v only", err, ErrReadInput)
+	}
+
+	unscorable := Options{scorer: failGematriaFor("all right move now from this area all right i will wait")}
+	_, err = newTexteeFromFile(unscorable, path)
+	if !errors.Is(err, ErrGematriaParse) || errors.Is(err, ErrOpenFile) || errors.Is(err, ErrReadInput) {
+		t.Errorf("NewTexteeFromFile() scoring failure error = %v, want %v without an I/O error", err, ErrGematriaParse)
+	}
+}
+
+// errAfterReader returns the bytes of r and then err instead of io.EOF
+type errAfterReader struct {
+	r   io.Reader
+	err error
+}
+
+func (e errAfterReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, e.err
+	}
+	return n, err
+}
+
+func TestTextee_ParseReader(t *testing.T) {
+	var b strings.Builder
+	for i := 0; b.Len() < 3*readChunkSize; i++ {
+		fmt.Fprintf(&b, "Sentence number %d says hello world again. ", i)
+	}
+	input := b.String()
+
+	want, err := NewTexteeWithOptions(Options{SkipGematria: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	got, err := want.Clone().ParseReader(iotest.OneByteReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.SortedSubstrings(), want.SortedSubstrings()) {
+		t.Error("ParseReader() counts differ from ParseString on the same input")
+	}
+	if n := len(got.Sentences()); n != 0 {
+		t.Errorf("ParseReader() kept %d sentences, want none so memory stays bounded", n)
+	}
+
+	long := strings.Repeat("word ", readChunkSize)
+	if _, err := got.ParseReader(strings.NewReader(long)); err != nil {
+		t.Fatalf("ParseReader() on one long sentence error = %v", err)
+	}
+	if got.Count("word word word") != readChunkSize-2 {
+		t.Errorf("Count(word word word) = %d, want %d", got.Count("word word word"), readChunkSize-2)
+	}
+
+	broken := errors.New("connection reset")
+	_, err = got.ParseReader(errAfterReader{r: strings.NewReader("Partial data."), err: broken})
+	if !errors.Is(err, ErrReadInput) || !errors.Is(err, broken) {
+		t.Errorf("ParseReader() error = %v, want %v joined with %v", err, ErrReadInput, broken)
+	}
+	if got.Count("partial data") != 1 {
+		t.Error("ParseReader() should count what was read before the error")
+	}
+}
+
+func TestChunker_Bounded(t *testing.T) {
+	tests := map[string]string{
+		"no whitespace":  strings.Repeat("a", 64),
+		"no terminators": strings.Repeat("word ", 13),
+		"multibyte":      strings.Repeat("aé", 21),
+	}
+	for name, piece := range tests {
+		t.Run(name, func(t *testing.T) {
+			var c chunker
+			data := []byte(strings.Repeat(piece, 12*readChunkSize/len(piece)))
+			var out []byte
+			for start := 0; start < len(data); start += readChunkSize {
+				c.write(data[start:min(start+readChunkSize, len(data))])
+				if chunk := c.next(); chunk != nil {
+					if !utf8.Valid(chunk) {
+						t.Fatal("next() split a rune")
+					}
+					out = append(out, chunk...)
+				}
+				if len(c.pending) > (maxPendingChunks+1)*readChunkSize {
+					t.Fatalf("pending = %d bytes, want at most %d", len(c.pending), (maxPendingChunks+1)*readChunkSize)
+				}
+			}
+			if out = append(out, c.pending...); string(out) != string(data) {
+				t.Error("chunks do not add back up to the input")
+			}
+		})
+	}
+
+	var c chunker
+	c.write([]byte(strings.Repeat("x", readChunkSize) + ` Done.") Next`))
+	if got := string(c.next()); !strings.HasSuffix(got, `Done.") `) {
+		t.Errorf("next() = ...%q, want a cut after the closing quote and parenthesis", got[max(len(got)-10, 0):])
+	}
+
+	tt, err := NewTexteeWithOptions(Options{SkipGematria: true, MaxNgram: 1}, "seed")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, err = tt.ParseReader(strings.NewReader(strings.Repeat("a", 10*readChunkSize))); err != nil {
+		t.Fatalf("ParseReader() without whitespace error = %v", err)
+	}
+	total := 0
+	for substring, quantity := range tt.Substrings {
+		total += len(substring) * int(quantity.Load())
+	}
+	if total != 10*readChunkSize {
+		t.Errorf("ParseReader() counted %d letters, want %d", total, 10*readChunkSize)
+	}
+	if n := len(tt.Sentences()); n != 0 {
+		t.Errorf("ParseReader() kept %d sentences, want none", n)
+	}
+}
+
+func TestNewTexteeFromReader(t *testing.T) {
+	var b strings.Builder
+	for i := 0; b.Len() < 2*readChunkSize; i++ {
+		fmt.Fprintf(&b, "Line %d of the stream. ", i%7)
+	}
+	input := b.String()
+	got, err := NewTexteeFromReader(iotest.HalfReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("NewTexteeFromReader() error = %v", err)
+	}
+	want, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.SortedSubstrings(), want.SortedSubstrings()) {
+		t.Error("NewTexteeFromReader() counts differ from NewTextee on the same input")
+	}
+	if !sameGematria(got.InputGematria(), want.InputGematria()) {
+		t.Errorf("InputGematria() = %+v, want the whole-input score %+v", got.InputGematria(), want.InputGematria())
+	}
+	if !sameGematria(got.Gematrias["of the stream"], want.Gematrias["of the stream"]) {
+		t.Error("NewTexteeFromReader() should score its substrings")
+	}
+	if got.Input != "" {
+		t.Errorf("Input holds %d bytes, want it left empty", len(got.Input))
+	}
+	if n := len(got.Sentences()); n != 0 {
+		t.Errorf("NewTexteeFromReader() kept %d sentences, want none", n)
+	}
+
+	if _, err := NewTexteeFromReader(strings.NewReader(" \n\t ")); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("NewTexteeFromReader() on whitespace error = %v, want %v", err, ErrEmptyInput)
+	}
+}
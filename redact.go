@@ -0,0 +1,105 @@
+package textee
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultRedactPlaceholder replaces redacted terms when Options.RedactPlaceholder is empty
+const DefaultRedactPlaceholder = "[REDACTED]"
+
+// redactSentinel marks redacted text while it is parsed. It is a private-use rune no preprocessor rewrites, so
+// segments still finds it after StripMarkdown, StripHTML or ExpandContractions, whatever the placeholder holds.
+const redactSentinel = "\uE000"
+
+// redacting reports whether any redaction is configured
+func (o Options) redacting() bool {
+	return len(o.Redact) > 0 || o.RedactPattern != nil
+}
+
+// placeholder returns the text redacted terms are replaced with
+func (o Options) placeholder() string {
+	if o.RedactPlaceholder == "" {
+		return DefaultRedactPlaceholder
+	}
+	return o.RedactPlaceholder
+}
+
+// compileRedact compiles the terms of Options.Redact into one case-insensitive expression, longest first, that
+// only matches a term followed by the end of the text or a character that is not a letter, digit or underscore.
+// The term itself is the first group. It returns nil when no term is left after trimming.
+func compileRedact(terms []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if term = strings.TrimSpace(term); term != "" {
+			quoted = append(quoted, regexp.QuoteMeta(term))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(quoted, func(a, b string) int { return len(b) - len(a) })
+	return regexp.MustCompile(`(?i)(` + strings.Join(quoted, "|") + `)(?:$|[^\pL\pN_])`)
+}
+
+// isWordRune reports whether r can be part of a word a redacted term must not be embedded in
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// redact replaces every configured term, matched case-insensitively as a whole word, and every match of
+// RedactPattern in input with redactSentinel, which unmark turns into the placeholder wherever the text is shown.
+// A term counts as a whole word when the characters either side of it are not letters, digits or underscores,
+// so terms that start or end with punctuation, such as "@alice", are matched as well.
+func (tt *Textee) redact(input string) string {
+	if !tt.opts.redacting() {
+		return input
+	}
+	input = strings.ReplaceAll(input, redactSentinel, "")
+	if tt.redactor != nil {
+		var b strings.Builder
+		last := 0
+		for pos := 0; pos < len(input); {
+			loc := tt.redactor.FindStringSubmatchIndex(input[pos:])
+			if loc == nil {
+				break
+			}
+			start, end := pos+loc[2], pos+loc[3]
+			if before, _ := utf8.DecodeLastRuneInString(input[:start]); start > 0 && isWordRune(before) {
+				_, size := utf8.DecodeRuneInString(input[start:])
+				pos = start + size
+				continue
+			}
+			b.WriteString(input[last:start])
+			b.WriteString(redactSentinel)
+			last, pos = end, end
+		}
+		if last > 0 {
+			b.WriteString(input[last:])
+			input = b.String()
+		}
+	}
+	if tt.opts.RedactPattern != nil {
+		input = tt.opts.RedactPattern.ReplaceAllLiteralString(input, redactSentinel)
+	}
+	return input
+}
+
+// unmark replaces every redactSentinel in s with the placeholder
+func (tt *Textee) unmark(s string) string {
+	if !strings.Contains(s, redactSentinel) {
+		return s
+	}
+	return strings.ReplaceAll(s, redactSentinel, tt.opts.placeholder())
+}
+
+// segments splits sentence around redacted text so it is never counted and no n-gram spans it
+func (tt *Textee) segments(sentence string) []string {
+	if !strings.Contains(sentence, redactSentinel) {
+		return []string{sentence}
+	}
+	return strings.Split(sentence, redactSentinel)
+}
@@ -0,0 +1,132 @@
+package textee
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestOptions_Redact(t *testing.T) {
+	opts := Options{
+		Redact:        []string{"John Smith", "acme"},
+		RedactPattern: regexp.MustCompile(`\d{3}-\d{4}`),
+	}
+	tt, err := NewTexteeWithOptions(opts, "Call John Smith at 555-1234. ACME hired john smith, then Acme left.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	forbidden := []string{"john", "smith", "acme", "555", "1234", "redacted"}
+	contains := func(s string) bool {
+		for _, word := range forbidden {
+			if strings.Contains(s, word) {
+				return true
+			}
+		}
+		return false
+	}
+	if want := "Call [REDACTED] at [REDACTED]. [REDACTED] hired [REDACTED], then [REDACTED] left."; tt.Input != want {
+		t.Errorf("Input = %q, want %q", tt.Input, want)
+	}
+	for substring := range tt.Substrings {
+		if contains(substring) {
+			t.Errorf("Substrings contains redacted %q", substring)
+		}
+	}
+	for substring := range tt.Gematrias {
+		if contains(substring) {
+			t.Errorf("Gematrias contains redacted %q", substring)
+		}
+	}
+	for _, cipher := range Ciphers() {
+		for _, bucket := range tt.scores(cipher) {
+			for _, substring := range bucket {
+				if contains(substring) {
+					t.Errorf("Scores %s contains redacted %q", cipher, substring)
+				}
+			}
+		}
+	}
+	if _, ok := tt.Substrings["call at"]; ok {
+		t.Error("Substrings should not span a redacted term")
+	}
+	if _, ok := tt.Substrings["hired"]; !ok {
+		t.Error("Substrings[hired] missing")
+	}
+}
+
+func TestOptions_RedactBoundaries(t *testing.T) {
+	tests := []struct {
+		name  string
+		terms []string
+		input string
+		want  string
+	}{
+		{"leading punctuation", []string{"@alice"}, "ping @alice today", "ping [REDACTED] today"},
+		{"trailing punctuation", []string{"c++"}, "we write c++, not go", "we write [REDACTED], not go"},
+		{"adjacent", []string{"al"}, "al al al ok", "[REDACTED] [REDACTED] [REDACTED] ok"},
+		{"embedded", []string{"al"}, "alan and sal", "alan and sal"},
+		{"longest first", []string{"al", "alice"}, "alice met al", "[REDACTED] met [REDACTED]"},
+		{"unicode word", []string{"ana"}, "ana émana", "[REDACTED] émana"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt, err := NewTexteeWithOptions(Options{Redact: tc.terms, SkipGematria: true}, tc.input)
+			if err != nil {
+				t.Fatalf("NewTexteeWithOptions() error = %v", err)
+			}
+			if tt.Input != tc.want {
+				t.Errorf("Input = %q, want %q", tt.Input, tc.want)
+			}
+		})
+	}
+
+	tt, err := NewTexteeWithOptions(Options{Redact: []string{"@alice"}}, "ping @alice today")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for substring := range tt.Substrings {
+		if strings.Contains(substring, "alice") {
+			t.Errorf("Substrings contains redacted %q", substring)
+		}
+	}
+}
+
+func TestOptions_RedactInputSize(t *testing.T) {
+	opts := Options{MaxInputBytes: 12, Redact: []string{"al"}}
+	tt, err := NewTexteeWithOptions(opts, "al and al ok")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, err = tt.ParseString("al and al ok"); err != nil {
+		t.Errorf("ParseString() error = %v, the size limit applies to the raw input", err)
+	}
+	if _, ok := tt.Substrings["and"]; !ok {
+		t.Error("Substrings[and] missing")
+	}
+	if _, err = tt.ParseString("al and al ok!"); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("ParseString() error = %v, want ErrInputTooLarge", err)
+	}
+}
+
+func TestOptions_RedactPlaceholderPreprocessed(t *testing.T) {
+	opts := Options{Redact: []string{"alice"}, RedactPlaceholder: "**gone**", StripMarkdown: true, ExpandContractions: true}
+	tt, err := NewTexteeWithOptions(opts, "Alice met bob. Then alice's dog ran.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if want := "**gone** met bob. Then **gone**'s dog ran."; tt.Input != want {
+		t.Errorf("Input = %q, want %q", tt.Input, want)
+	}
+	for substring := range tt.Substrings {
+		if strings.Contains(substring, "gone") || strings.Contains(substring, "alice") {
+			t.Errorf("Substrings contains the placeholder or the term in %q", substring)
+		}
+	}
+	if _, ok := tt.Substrings["met bob"]; !ok {
+		t.Error("Substrings[met bob] missing")
+	}
+	if got := tt.Sentences()[0]; got != "**gone** met bob." {
+		t.Errorf("Sentences()[0] = %q, want the placeholder shown", got)
+	}
+}
@@ -0,0 +1,112 @@
+package textee
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// Sentences returns a copy of the sentences the last ParseString split its input into, in input order. They
+// are replaced on every parse and are serialized with the Textee, under "sents" in JSON, so the sentence-level
+// methods keep working on a decoded Textee.
+func (tt *Textee) Sentences() []string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	sentences := make([]string, len(tt.sentences))
+	for i, sentence := range tt.sentences {
+		sentences[i] = tt.unmark(sentence)
+	}
+	return sentences
+}
+
+// SentenceScore pairs a sentence with the gematria of its cleaned words
+type SentenceScore struct {
+	Sentence string `json:"s"`
+	gematria.Gematria
+}
+
+// SentenceScores returns the gematria of every sentence in the order they appear in the input
+func (tt *Textee) SentenceScores() []SentenceScore {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	scores := make([]SentenceScore, 0, len(tt.sentences))
+	for _, sentence := range tt.sentences {
+		scores = append(scores, SentenceScore{Sentence: tt.unmark(sentence), Gematria: tt.sentenceGematria(sentence)})
+	}
+	return scores
+}
+
+// LongestIncreasingScoreRun finds the longest run of consecutive sentences whose cipher values strictly
+// increase, returning the index of its first sentence and its length. The earliest run wins ties and a
+// Textee without sentences returns 0, 0.
+func (tt *Textee) LongestIncreasingScoreRun(cipher Cipher) (start, length int) {
+	var previous uint64
+	runStart := 0
+	for i, score := range tt.SentenceScores() {
+		value := cipher.Value(score.Gematria)
+		if i > 0 && value <= previous {
+			runStart = i
+		}
+		if i-runStart+1 > length {
+			start, length = runStart, i-runStart+1
+		}
+		previous = value
+	}
+	return start, length
+}
+
+// sentenceGematria scores the cleaned words of sentence like a substring, Options.GematriaFallback included, and
+// a sentence that still cannot be scored counts as zero
+func (tt *Textee) sentenceGematria(sentence string) gematria.Gematria {
+	gem, err := tt.score(strings.Join(tt.sentenceWords(sentence), " "))
+	if err != nil {
+		return gematria.Gematria{}
+	}
+	return gem
+}
+
+// SentenceTF returns the fraction of sentences containing substring as consecutive words, after normalizing it
+// like Count. With Options.CanonicalStopwords the stopwords of each sentence are skipped, as they are when its
+// n-grams are counted. A Textee without sentences returns 0.
+func (tt *Textee) SentenceTF(substring string) float64 {
+	normalized, ok := tt.key(substring)
+	if !ok {
+		return 0
+	}
+	phrase := strings.Fields(normalized)
+	stop := tt.opts.stopwordSet()
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	if len(tt.sentences) == 0 {
+		return 0
+	}
+	containing := 0
+	for _, sentence := range tt.sentences {
+		words := tt.sentenceWords(sentence)
+		if stop != nil {
+			words = slices.DeleteFunc(words, func(word string) bool { return stop[strings.ToLower(word)] })
+		}
+		if containsPhrase(words, phrase) {
+			containing++
+		}
+	}
+	return float64(containing) / float64(len(tt.sentences))
+}
+
+// containsPhrase reports whether phrase appears as consecutive entries of words
+func containsPhrase(words, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		match := true
+		for j := range phrase {
+			if words[i+j] != phrase[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+func TestTextee_LongestIncreasingScoreRun(t *testing.T) {
+	// simple gematria per sentence: 3, 1, 2, 4, 5, 1
+	tt, err := NewTextee("C. A. B. D. E. A.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	start, length := tt.LongestIncreasingScoreRun(CipherSimple)
+	if start != 1 || length != 4 {
+		t.Errorf("LongestIncreasingScoreRun() = %d, %d, want 1, 4", start, length)
+	}
+
+	descending, err := NewTextee("E. D. C.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	start, length = descending.LongestIncreasingScoreRun(CipherSimple)
+	if start != 0 || length != 1 {
+		t.Errorf("LongestIncreasingScoreRun() = %d, %d, want 0, 1", start, length)
+	}
+}
+
+func TestTextee_SentenceTF(t *testing.T) {
+	tt, err := NewTextee("The red fox ran. A blue bird sang. The Red Fox slept. Nothing here.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got := tt.SentenceTF("red fox"); got != 0.5 {
+		t.Errorf("SentenceTF(red fox) = %v, want 0.5", got)
+	}
+	if got := tt.SentenceTF("fox red"); got != 0 {
+		t.Errorf("SentenceTF(fox red) = %v, want 0", got)
+	}
+}
+
+func TestTextee_SentenceScores(t *testing.T) {
+	tt, err := NewTextee("Manifesting three. Six nine!")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	scores := tt.SentenceScores()
+	if len(scores) != 2 {
+		t.Fatalf("SentenceScores() returned %d sentences, want 2", len(scores))
+	}
+	if scores[0].Sentence != "Manifesting three." || scores[1].Sentence != "Six nine!" {
+		t.Errorf("SentenceScores() sentences = %q, %q", scores[0].Sentence, scores[1].Sentence)
+	}
+	if want := tt.Gematrias["manifesting three"]; !sameGematria(scores[0].Gematria, want) {
+		t.Errorf("SentenceScores()[0] = %v, want %v", scores[0].Gematria, want)
+	}
+	if got := scores[1].English; got != 564 {
+		t.Errorf("SentenceScores()[1].English = %d, want 564", got)
+	}
+
+	fallback := gematria.Gematria{English: 7}
+	tt, err = NewTexteeWithOptions(Options{
+		GematriaFallback: func(string) gematria.Gematria { return fallback },
+		scorer:           failGematriaFor("six nine"),
+	}, "Manifesting three. Six nine!")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.SentenceScores()[1].Gematria; !sameGematria(got, fallback) {
+		t.Errorf("SentenceScores()[1] = %v, want the GematriaFallback score %v", got, fallback)
+	}
+}
+
+func TestTextee_Sentences(t *testing.T) {
+	tt, err := NewTextee("First one. Second one!")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got, want := tt.Sentences(), []string{"First one.", "Second one!"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sentences() = %q, want %q", got, want)
+	}
+	tt.Sentences()[0] = "mutated"
+	if tt.sentences[0] == "mutated" {
+		t.Error("Sentences() returned the internal slice")
+	}
+	if _, err := tt.ParseString("Replaced entirely?"); err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+	if got, want := tt.Sentences(), []string{"Replaced entirely?"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sentences() after reparse = %q, want %q", got, want)
+	}
+}
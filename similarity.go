@@ -0,0 +1,49 @@
+package textee
+
+import "math"
+
+// SimilarityMetric names a way of comparing the substring frequencies of two Textees, see Similarity
+type SimilarityMetric string
+
+const (
+	// SimilarityCosine is the cosine of the angle between the two substring-frequency vectors
+	SimilarityCosine SimilarityMetric = "cosine"
+	// SimilarityJaccard is the weighted Jaccard index, the sum of the smaller counts over the sum of the larger
+	// ones, which is the plain Jaccard index of the two substring sets when every count is 1
+	SimilarityJaccard SimilarityMetric = "jaccard"
+)
+
+// Similarity scores how alike the substring frequencies of tt and other are under metric, from 0 for nothing
+// in common to 1 for identical frequencies. A nil or empty side and an unknown metric score 0.
+func (tt *Textee) Similarity(other *Textee, metric SimilarityMetric) float64 {
+	a, b := tt.counts(), other.counts()
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	switch metric {
+	case SimilarityCosine:
+		var dot, normA, normB float64
+		for substring, x := range a {
+			normA += float64(x) * float64(x)
+			dot += float64(x) * float64(b[substring])
+		}
+		for _, y := range b {
+			normB += float64(y) * float64(y)
+		}
+		return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+	case SimilarityJaccard:
+		var lower, upper float64
+		for substring, x := range a {
+			y := b[substring]
+			lower += float64(min(x, y))
+			upper += float64(max(x, y))
+		}
+		for substring, y := range b {
+			if _, ok := a[substring]; !ok {
+				upper += float64(y)
+			}
+		}
+		return lower / upper
+	}
+	return 0
+}
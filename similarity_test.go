@@ -0,0 +1,42 @@
+package textee
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTextee_Similarity(t *testing.T) {
+	parse := func(input string) *Textee {
+		t.Helper()
+		tt, err := NewTexteeWithOptions(Options{MaxNgram: 1, SkipGematria: true}, input)
+		if err != nil {
+			t.Fatalf("NewTexteeWithOptions() error = %v", err)
+		}
+		return tt
+	}
+	a := parse("red red fish")
+	b := parse("red fish fish")
+	c := parse("blue boat")
+
+	tests := []struct {
+		name   string
+		other  *Textee
+		metric SimilarityMetric
+		want   float64
+	}{
+		{name: "cosine", other: b, metric: SimilarityCosine, want: 4.0 / 5.0},
+		{name: "jaccard", other: b, metric: SimilarityJaccard, want: 2.0 / 4.0},
+		{name: "cosine of itself", other: a, metric: SimilarityCosine, want: 1},
+		{name: "jaccard of itself", other: a, metric: SimilarityJaccard, want: 1},
+		{name: "nothing shared", other: c, metric: SimilarityCosine, want: 0},
+		{name: "nil other", other: nil, metric: SimilarityJaccard, want: 0},
+		{name: "unknown metric", other: b, metric: "euclid", want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := a.Similarity(tc.other, tc.metric); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("Similarity() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
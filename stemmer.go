@@ -0,0 +1,33 @@
+package textee
+
+import "strings"
+
+// SuffixStemmer is a small suffix-stripping stemmer for Options.Stemmer that folds common English inflections
+// such as "runs" and "running" into "run". It only expects the cleaned, lowercased words ParseString hands it.
+func SuffixStemmer(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses"):
+		return word[:len(word)-2]
+	}
+	for _, suffix := range []string{"ing", "ed"} {
+		if stem := strings.TrimSuffix(word, suffix); stem != word && len(stem) >= 3 && strings.ContainsAny(stem, "aeiouy") {
+			return undouble(stem)
+		}
+	}
+	if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// undouble drops the last letter of a stem ending in a doubled consonant, so "runn" becomes "run" while "fall"
+// and "miss" are kept
+func undouble(stem string) string {
+	n := len(stem)
+	if n < 2 || stem[n-1] != stem[n-2] || strings.IndexByte("aeioulsz", stem[n-1]) >= 0 {
+		return stem
+	}
+	return stem[:n-1]
+}
@@ -0,0 +1,77 @@
+package textee
+
+import "testing"
+
+func TestSuffixStemmer(t *testing.T) {
+	tests := map[string]string{
+		"run":     "run",
+		"runs":    "run",
+		"running": "run",
+		"jumped":  "jump",
+		"falling": "fall",
+		"stories": "story",
+		"classes": "class",
+		"sing":    "sing",
+		"bus":     "bus",
+	}
+	for word, want := range tests {
+		if got := SuffixStemmer(word); got != want {
+			t.Errorf("SuffixStemmer(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestOptions_Stemmer(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{Stemmer: SuffixStemmer}, "Run. Runs. Running fast.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.Substrings["run"].Load(); got != 3 {
+		t.Errorf("Substrings[run] = %d, want 3", got)
+	}
+	if _, ok := tt.Substrings["running"]; ok {
+		t.Error("Substrings should not contain the unstemmed running")
+	}
+	if _, ok := tt.Gematrias["run fast"]; !ok {
+		t.Error("Gematrias should be calculated on the stemmed run fast")
+	}
+}
+
+func TestOptions_StemmerLookups(t *testing.T) {
+	opts := Options{Stemmer: SuffixStemmer, SurfaceForms: true}
+	tt, err := NewTexteeWithOptions(opts, "Run. Runs. Running fast.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.Count("running"); got != 3 {
+		t.Errorf("Count(running) = %d, want 3", got)
+	}
+	if rank, _, _, ok := tt.Rank("running"); !ok || rank != 1 {
+		t.Errorf("Rank(running) = %d, %v, want 1, true", rank, ok)
+	}
+	if got := tt.WithPrefix("running"); len(got) != 2 || got[0].Substring != "run" || got[1].Substring != "run fast" {
+		t.Errorf("WithPrefix(running) = %v, want run and run fast", got)
+	}
+	if got := tt.WithSuffix("Running fast"); len(got) != 1 || got[0].Substring != "run fast" {
+		t.Errorf("WithSuffix(Running fast) = %v, want run fast", got)
+	}
+	if got := tt.SentenceTF("running"); got != 1 {
+		t.Errorf("SentenceTF(running) = %v, want 1", got)
+	}
+	gem, err := tt.GematriaOf("running")
+	if err != nil {
+		t.Fatalf("GematriaOf() error = %v", err)
+	}
+	if !sameGematria(gem, tt.Gematrias["run"]) {
+		t.Errorf("GematriaOf(running) = %+v, want the stored run score %+v", gem, tt.Gematrias["run"])
+	}
+	if got := tt.FuzzyMatch("runing", 1); len(got) == 0 || got[0].Substring != "run" {
+		t.Errorf("FuzzyMatch(runing) = %v, want run first", got)
+	}
+	if got := tt.SurfaceForms("runs"); got["run"] != 3 {
+		t.Errorf("SurfaceForms(runs) = %v, want the forms of run", got)
+	}
+	if got := NewCorpus(tt).DocumentFrequency("running"); got != 1 {
+		t.Errorf("DocumentFrequency(running) = %d, want 1", got)
+	}
+}
@@ -0,0 +1,58 @@
+package textee
+
+import "strings"
+
+// stopwords lists the common English words CanonicalStopwords removes when Options.Stopwords is empty
+var stopwords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "from", "in", "into", "is", "it", "of",
+	"on", "or", "that", "the", "this", "to", "was", "were", "with",
+}
+
+// DefaultStopwords returns a copy of the stopwords CanonicalStopwords uses when none are configured
+func DefaultStopwords() []string {
+	return append([]string(nil), stopwords...)
+}
+
+// stopwordSet returns the configured stopwords as a lookup set, or nil when CanonicalStopwords is off. With a
+// Stemmer the set holds the stemmed stopwords, since the words checked against it have already been stemmed.
+func (o Options) stopwordSet() map[string]bool {
+	if !o.CanonicalStopwords {
+		return nil
+	}
+	words := o.Stopwords
+	if len(words) == 0 {
+		words = stopwords
+	}
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		word, err := o.normalizeSubstring(word)
+		if err == nil && word != "" && o.Stemmer != nil {
+			word = o.Stemmer(word)
+		}
+		if err == nil && word != "" {
+			set[word] = true
+		}
+	}
+	return set
+}
+
+// withoutStopwords returns the canonical key of the n-gram window, its normalized words without any in stop.
+// Windows that start or end with a stopword are rejected, since their canonical form would only repeat that of
+// a shorter window, so "king of england" counts as "king england" while "king of" and "of" count nothing.
+func (tt *Textee) withoutStopwords(window []string, stop map[string]bool) (string, bool) {
+	kept := make([]string, 0, len(window))
+	for i, token := range window {
+		word, err := tt.normalize(token)
+		if err != nil || word == "" {
+			continue
+		}
+		if stop[strings.ToLower(word)] {
+			if i == 0 || i == len(window)-1 {
+				return "", false
+			}
+			continue
+		}
+		kept = append(kept, word)
+	}
+	return strings.Join(kept, " "), len(kept) > 0
+}
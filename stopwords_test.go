@@ -0,0 +1,58 @@
+package textee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOptions_CanonicalStopwords(t *testing.T) {
+	opts := Options{CanonicalStopwords: true, SurfaceForms: true}
+	tt, err := NewTexteeWithOptions(opts, "The King of England spoke. King England spoke.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.Substrings["king england"].Load(); got != 2 {
+		t.Errorf("Substrings[king england] = %d, want the variants merged into 2", got)
+	}
+	if got := tt.Substrings["king"].Load(); got != 2 {
+		t.Errorf("Substrings[king] = %d, want 2", got)
+	}
+	for _, substring := range []string{"the", "of", "king of", "the king", "king of england"} {
+		if _, ok := tt.Substrings[substring]; ok {
+			t.Errorf("Substrings[%q] should not be counted", substring)
+		}
+	}
+	want := map[string]int{"King of England": 1, "King England": 1}
+	if got := tt.SurfaceForms("king england"); !reflect.DeepEqual(got, want) {
+		t.Errorf("SurfaceForms(king england) = %v, want %v", got, want)
+	}
+	if got := tt.SentenceTF("King of England"); got != 1 {
+		t.Errorf("SentenceTF(King of England) = %v, want both sentences", got)
+	}
+	if rank, _, _, ok := tt.Rank("the king of england"); ok {
+		t.Errorf("Rank(the king of england) = %d, want a window starting with a stopword missing", rank)
+	}
+
+	custom, err := NewTexteeWithOptions(Options{CanonicalStopwords: true, Stopwords: []string{"big"}}, "a big dog")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, ok := custom.Substrings["a dog"]; !ok {
+		t.Errorf("Substrings = %v, want a dog with custom stopwords", custom.Substrings)
+	}
+}
+
+func TestOptions_CanonicalStopwordsStemmed(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{CanonicalStopwords: true, Stemmer: SuffixStemmer}, "this house was big")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"thi", "thi house", "this house"} {
+		if _, ok := tt.Substrings[substring]; ok {
+			t.Errorf("Substrings[%q] should not be counted, this is a stopword", substring)
+		}
+	}
+	if got := tt.Count("house was big"); got != 1 {
+		t.Errorf("Count(house was big) = %d, want 1", got)
+	}
+}
@@ -0,0 +1,69 @@
+package textee
+
+import (
+	"container/heap"
+	"context"
+	"iter"
+)
+
+// Stream sends the SortedSubstrings of tt, captured when Stream is called, one at a time over the returned
+// channel and closes it once every entry is sent or ctx is cancelled. The channel is unbuffered so the caller
+// must drain it or cancel ctx, otherwise the sending goroutine is left blocked.
+func (tt *Textee) Stream(ctx context.Context) <-chan SubstringQuantity {
+	sorted := tt.SortedSubstrings()
+	out := make(chan SubstringQuantity)
+	go func() {
+		defer close(out)
+		for _, sq := range sorted {
+			select {
+			case out <- sq:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// All yields the substrings of tt in SortedSubstrings order. The counts are captured under the read lock when
+// iteration starts but are ordered lazily through a heap, so breaking out early skips most of the sorting.
+func (tt *Textee) All() iter.Seq[SubstringQuantity] {
+	return func(yield func(SubstringQuantity) bool) {
+		tt.mu.RLock()
+		pending := make(substringHeap, 0, len(tt.Substrings))
+		for substring, quantity := range tt.Substrings {
+			pending = append(pending, SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())})
+		}
+		tt.mu.RUnlock()
+		heap.Init(&pending)
+		for pending.Len() > 0 {
+			sq := heap.Pop(&pending).(SubstringQuantity)
+			if tt.opts.DominantSurface {
+				tt.mu.RLock()
+				sq.Substring = tt.display(sq.Substring)
+				tt.mu.RUnlock()
+			}
+			if !yield(sq) {
+				return
+			}
+		}
+	}
+}
+
+// substringHeap orders SubstringQuantity values like SortedStringQuantities for container/heap
+type substringHeap SortedStringQuantities
+
+func (h substringHeap) Len() int           { return len(h) }
+func (h substringHeap) Less(i, j int) bool { return SortedStringQuantities(h).Less(i, j) }
+func (h substringHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+// Push is part of heap.Interface.
+func (h *substringHeap) Push(x any) { *h = append(*h, x.(SubstringQuantity)) }
+
+// Pop is part of heap.Interface.
+func (h *substringHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,53 @@
+package textee
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestTextee_Stream(t *testing.T) {
+	tt, err := NewTextee("one two. one.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var got SortedStringQuantities
+	for sq := range tt.Stream(context.Background()) {
+		got = append(got, sq)
+	}
+	if want := tt.SortedSubstrings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Stream() = %v, want %v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := tt.Stream(ctx)
+	<-stream
+	cancel()
+	for range stream {
+	}
+}
+
+func TestTextee_All(t *testing.T) {
+	tt, err := NewTextee("red fish. red fish. blue fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var got SortedStringQuantities
+	for sq := range tt.All() {
+		got = append(got, sq)
+	}
+	if want := tt.SortedSubstrings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	var first []SubstringQuantity
+	for sq := range tt.All() {
+		first = append(first, sq)
+		if len(first) == 2 {
+			break
+		}
+	}
+	if len(first) != 2 || first[0].Substring != "fish" {
+		t.Errorf("All() with an early break = %v, want fish first", first)
+	}
+}
@@ -10,7 +10,11 @@ func (sq SortedStringQuantities) Swap(i, j int) {
 	sq[i], sq[j] = sq[j], sq[i]
 }
 
-// Less is part of sort.Interface. We use it to sort the slice by Quantity in descending order.
+// Less is part of sort.Interface. We use it to sort the slice by Quantity in descending order, breaking
+// ties alphabetically so the order is stable between runs.
 func (sq SortedStringQuantities) Less(i, j int) bool {
-	return sq[i].Quantity > sq[j].Quantity
+	if sq[i].Quantity != sq[j].Quantity {
+		return sq[i].Quantity > sq[j].Quantity
+	}
+	return sq[i].Substring < sq[j].Substring
 }
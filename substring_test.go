@@ -0,0 +1,28 @@
+package textee
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortedStringQuantities_Less(t *testing.T) {
+	got := SortedStringQuantities{
+		{Substring: "pear", Quantity: 1},
+		{Substring: "fig", Quantity: 3},
+		{Substring: "apple", Quantity: 1},
+		{Substring: "date", Quantity: 3},
+		{Substring: "kiwi", Quantity: 2},
+	}
+	want := SortedStringQuantities{
+		{Substring: "date", Quantity: 3},
+		{Substring: "fig", Quantity: 3},
+		{Substring: "kiwi", Quantity: 2},
+		{Substring: "apple", Quantity: 1},
+		{Substring: "pear", Quantity: 1},
+	}
+	sort.Sort(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sort.Sort() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package textee
+
+import (
+	"maps"
+	"strings"
+)
+
+// recordSurface adds n to the count of surface, cleaned but with its capitalization kept, as a form of
+// substring. Callers must hold tt.mu.
+func (tt *Textee) recordSurface(substring, surface string, n int) {
+	cleaned, err := tt.opts.clean(surface)
+	if err != nil {
+		return
+	}
+	cleaned = strings.Join(strings.Fields(cleaned), " ")
+	if tt.surfaces[substring] == nil {
+		tt.surfaces[substring] = make(map[string]int)
+	}
+	tt.surfaces[substring][cleaned] += n
+}
+
+// SurfaceForms returns a fresh map of the texts substring, normalized like Count, was counted from along with
+// how often each occurred. It is empty unless Options.SurfaceForms is set.
+func (tt *Textee) SurfaceForms(substring string) map[string]int {
+	normalized, _ := tt.key(substring)
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	forms := maps.Clone(tt.surfaces[normalized])
+	if forms == nil {
+		forms = make(map[string]int)
+	}
+	return forms
+}
+
+// display returns the form substring is reported under, its DominantSurface when Options.DominantSurface is
+// set and substring itself otherwise. Callers must hold tt.mu.
+func (tt *Textee) display(substring string) string {
+	if !tt.opts.DominantSurface {
+		return substring
+	}
+	return tt.dominantSurface(substring)
+}
+
+// dominantSurface returns the most frequent surface form of substring, breaking ties by the lowest in byte
+// order so "NASA" wins over "nasa", or substring itself when none were recorded. Callers must hold tt.mu.
+func (tt *Textee) dominantSurface(substring string) string {
+	best, bestCount := substring, 0
+	for form, count := range tt.surfaces[substring] {
+		if count > bestCount || (count == bestCount && form < best) {
+			best, bestCount = form, count
+		}
+	}
+	return best
+}
+
+// DominantSurface returns the most common original form substring, normalized like Count, was counted from. Ties
+// go to the form lowest in byte order and the normalized substring is returned when no forms were recorded, since
+// forms are only tracked with Options.SurfaceForms or Options.DominantSurface set.
+func (tt *Textee) DominantSurface(substring string) string {
+	normalized, _ := tt.queryForm(substring)
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return tt.dominantSurface(normalized)
+}
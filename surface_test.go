@@ -0,0 +1,42 @@
+package textee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOptions_DominantSurface(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{DominantSurface: true}, "NASA launched. NASA landed. nasa slept. Nasa woke.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tt.Substrings["nasa"].Load(); got != 4 {
+		t.Errorf("Substrings[nasa] = %d, want the forms aggregated into 4", got)
+	}
+	if first := tt.SortedSubstrings()[0]; first != (SubstringQuantity{"NASA", 4}) {
+		t.Errorf("SortedSubstrings()[0] = %v, want NASA reported with 4", first)
+	}
+	for sq := range tt.All() {
+		if sq.Substring != "NASA" {
+			t.Errorf("All() first = %v, want NASA", sq)
+		}
+		break
+	}
+	if !strings.Contains(tt.String(), "\"NASA\": 4 [English") {
+		t.Errorf("String() should report NASA with its scores:\n%s", tt.String())
+	}
+	if got := tt.DominantSurface("nasa slept"); got != "nasa slept" {
+		t.Errorf("DominantSurface(nasa slept) = %q", got)
+	}
+
+	tied, err := NewTexteeWithOptions(Options{DominantSurface: true}, "Go now. go now.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if got := tied.DominantSurface("go"); got != "Go" {
+		t.Errorf("DominantSurface(go) = %q, want the tie broken toward Go", got)
+	}
+	if plain, _ := NewTextee("NASA launched."); plain.SortedSubstrings()[0].Substring != "launched" {
+		t.Error("SortedSubstrings() should report lowercased keys by default")
+	}
+}
@@ -1,27 +1,79 @@
 package textee
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"github.com/andreimerlescu/gematria"
 )
 
 func NewTextee(in ...string) (*Textee, error) {
-	if in == nil {
-		return nil, ErrEmptyInput
+	return NewTexteeWithOptions(Options{}, in...)
+}
+
+// NewTexteeContext parses and scores in like NewTextee but stops as soon as ctx is done, returning its error
+// joined with ErrBadParsing
+func NewTexteeContext(ctx context.Context, in ...string) (*Textee, error) {
+	return newTexteeContext(ctx, Options{}, in...)
+}
+
+// NewTexteeWithOptions parses and scores in like NewTextee using the behavior configured by opts. Input that
+// holds nothing but whitespace once joined, including no arguments at all, returns ErrEmptyInput.
+func NewTexteeWithOptions(opts Options, in ...string) (*Textee, error) {
+	return newTexteeContext(context.Background(), opts, in...)
+}
+
+func newTexteeContext(ctx context.Context, opts Options, in ...string) (*Textee, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.MaxInputBytes > 0 {
+		size := len(in) - 1
+		for _, part := range in {
+			size += len(part)
+		}
+		if size > opts.MaxInputBytes {
+			return nil, ErrInputTooLarge
+		}
 	}
 
-	input := strings.Join(in, " ")
-	gem, err := gematria.NewGematria(input)
+	joined := strings.Join(in, " ")
+	if strings.TrimSpace(joined) == "" {
+		return nil, ErrEmptyInput
+	}
+	tt := newTextee(opts, "", gematria.Gematria{})
+	input := tt.redact(joined)
+	tt.Input = tt.unmark(input)
+	tt.startMetrics()
+	sentences, err := tt.parseRedacted(ctx, input)
 	if err != nil {
+		return nil, errors.Join(ErrBadParsing, err)
+	}
+	if tt.Gematria, err = tt.sentencesGematria(sentences); err != nil {
 		return nil, err
 	}
-	tt := &Textee{
+	if !opts.FusedGematria && !opts.SkipGematria {
+		if err = tt.calculateGematria(ctx); err != nil {
+			return nil, errors.Join(ErrBadParsing, err)
+		}
+	}
+	tt.reportMetrics()
+	return tt, nil
+}
+
+// newTextee returns an empty Textee for input with every map allocated
+func newTextee(opts Options, input string, gem gematria.Gematria) *Textee {
+	return &Textee{
 		Input:          input,
 		Gematria:       gem,
 		Substrings:     make(map[string]*atomic.Int32),
@@ -32,94 +84,469 @@ func NewTextee(in ...string) (*Textee, error) {
 		ScoresMystery:  make(map[uint64][]string),
 		ScoresEights:   make(map[uint64][]string),
 		ScoresMajestic: make(map[uint64][]string),
+		opts:           opts,
+		redactor:       compileRedact(opts.Redact),
 	}
-	payload := strings.Join(in, " ")
-	tt, err = tt.ParseString(payload)
-	if err != nil {
-		return nil, errors.Join(ErrBadParsing, err)
+}
+
+// sentencesGematria scores the words ParseString counts in sentences, after preprocessing, tokenizing and
+// cleaning, joined by single spaces so the whole-input score is comparable with the scores of its substrings
+func (tt *Textee) sentencesGematria(sentences []string) (gematria.Gematria, error) {
+	var words []string
+	for _, sentence := range sentences {
+		words = append(words, tt.sentenceWords(sentence)...)
 	}
-	tt, err = tt.CalculateGematria()
+	return tt.scoreWords(words)
+}
+
+// scoreWords scores words joined by single spaces, wrapping any failure in ErrGematriaParse
+func (tt *Textee) scoreWords(words []string) (gematria.Gematria, error) {
+	gem, err := tt.opts.scoreGematria(strings.Join(words, " "))
 	if err != nil {
-		return nil, errors.Join(ErrBadParsing, err)
+		return gematria.Gematria{}, errors.Join(ErrGematriaParse, err)
 	}
-	return tt, nil
+	return gem, nil
+}
+
+// InputGematria returns the score of the whole input. It is calculated from the same cleaned words the
+// substrings are built from, so punctuation, markup, redacted text and anything else ParseString discards
+// never count towards it, and Merge sums it across the merged sources.
+func (tt *Textee) InputGematria() gematria.Gematria {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return tt.Gematria
 }
 
+// ParseString splits input into sentences and counts its n-grams into a fresh Substrings map. Every n-gram
+// window lies strictly within one non-empty sentence, or within the whole input with Options.CrossSentence,
+// and sentences without letters or digits are dropped before any goroutine starts. An n-gram that
+// fails to clean is skipped and listed by CleanFailures unless Options.StrictCleaning is set, which returns the
+// errors instead. It always returns tt, so on error the caller keeps its reference and can inspect whatever was
+// parsed before the failure.
 func (tt *Textee) ParseString(input string) (*Textee, error) {
-	sentences, err := stringToSentenceSlice(input)
+	return tt.ParseStringContext(context.Background(), input)
+}
+
+// ParseStringContext parses input like ParseString but stops counting as soon as ctx is done, returning its
+// error with whatever was counted so far
+func (tt *Textee) ParseStringContext(ctx context.Context, input string) (*Textee, error) {
+	if tt.opts.MaxInputBytes > 0 && len(input) > tt.opts.MaxInputBytes {
+		return tt, ErrInputTooLarge
+	}
+	_, err := tt.parseRedacted(ctx, tt.redact(input))
+	return tt, err
+}
+
+// parseRedacted parses input, which has already been checked against Options.MaxInputBytes and redacted, into a
+// fresh Substrings map for ParseStringContext, returning the sentences it was split into
+func (tt *Textee) parseRedacted(ctx context.Context, input string) ([]string, error) {
+	sentences, err := tt.splitSentences(tt.preprocess(input))
 	if err != nil {
 		return nil, errors.Join(ErrBadParsing, err)
 	}
 
+	started := tt.resetCounts()
+	state := tt.newParseState()
+	state.ctx = ctx
+	tt.countSentences(sentences, state)
+	if err := ctx.Err(); err != nil {
+		return sentences, err
+	}
+	return sentences, tt.finishParse(state, started)
+}
+
+// AppendString counts the n-grams of input on top of the existing Substrings instead of replacing them, appending
+// input to Input and adding its score to Gematria. Unless Options.SkipGematria is set only the substrings it
+// creates are scored, through CalculateGematriaFor, so the Scores* maps are updated without being rebuilt.
+func (tt *Textee) AppendString(input string) (*Textee, error) {
+	if tt.opts.MaxInputBytes > 0 && len(input) > tt.opts.MaxInputBytes {
+		return tt, ErrInputTooLarge
+	}
+	input = tt.redact(input)
+	sentences, err := tt.splitSentences(tt.preprocess(input))
+	if err != nil {
+		return tt, errors.Join(ErrBadParsing, err)
+	}
+	gem, err := tt.sentencesGematria(sentences)
+	if err != nil {
+		return tt, err
+	}
+
 	tt.mu.Lock()
-	tt.Substrings = make(map[string]*atomic.Int32)
+	if tt.Substrings == nil {
+		tt.Substrings = make(map[string]*atomic.Int32)
+	}
+	if tt.surfaces == nil && (tt.opts.SurfaceForms || tt.opts.DominantSurface) {
+		tt.surfaces = make(map[string]map[string]int)
+	}
+	tt.Input = strings.TrimSpace(tt.Input + " " + tt.unmark(input))
+	tt.Gematria = addGematria(tt.Gematria, gem)
 	tt.mu.Unlock()
 
-	var errs []CleanError
+	state := tt.newParseState()
+	state.fuse = false
+	tt.countSentences(sentences, state)
+	tt.mu.Lock()
+	tt.addCleanFailures(state)
+	tt.mu.Unlock()
+	if err := state.strictCleanError(tt.opts); err != nil {
+		return tt, err
+	}
+	if tt.opts.SkipGematria {
+		return tt, nil
+	}
+	return tt.CalculateGematriaFor(state.created)
+}
+
+// addCleanFailures merges the clean failures of state into the sorted CleanFailures. Callers must hold tt.mu.
+func (tt *Textee) addCleanFailures(state *parseState) {
+	for substring := range state.cleanFailures {
+		i := sort.SearchStrings(tt.cleanFailures, substring)
+		if i == len(tt.cleanFailures) || tt.cleanFailures[i] != substring {
+			tt.cleanFailures = slices.Insert(tt.cleanFailures, i, substring)
+		}
+	}
+}
+
+// resetCounts empties Substrings and everything derived from counting them, returning when it did so if
+// Metrics are being collected
+func (tt *Textee) resetCounts() time.Time {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	var started time.Time
+	if tt.metrics != nil {
+		started = time.Now()
+	}
+	tt.Substrings = make(map[string]*atomic.Int32)
+	tt.evictions = 0
+	tt.surfaces = nil
+	if tt.opts.SurfaceForms || tt.opts.DominantSurface {
+		tt.surfaces = make(map[string]map[string]int)
+	}
+	tt.sentences = nil
+	return started
+}
+
+// countSentences appends sentences to tt, unless state discards them, and counts their n-grams into state, one
+// goroutine per stream
+func (tt *Textee) countSentences(sentences []string, state *parseState) {
+	if !state.discardSentences {
+		tt.mu.Lock()
+		tt.sentences = append(tt.sentences, sentences...)
+		tt.mu.Unlock()
+	}
+
+	streams := sentences
+	if tt.opts.CrossSentence {
+		streams = []string{strings.Join(sentences, " ")}
+	}
+	var segments []string
+	for _, stream := range streams {
+		segments = append(segments, tt.segments(stream)...)
+	}
+	streams = segments
+
 	var wg sync.WaitGroup
-	for _, sentence := range sentences {
+	spawned := 0
+	for _, sentence := range streams {
+		if state.ctx.Err() != nil {
+			break
+		}
+		if strings.TrimSpace(sentence) == "" {
+			continue
+		}
+		spawned++
 		wg.Add(1)
 		go func(sentence string) {
 			defer wg.Done()
-			words := strings.Fields(sentence)
-
-			for i := 0; i < len(words); i++ {
-				for j := i + 1; j <= i+3 && j <= len(words); j++ {
-					substring := strings.Join(words[i:j], " ")
-					cleanedSubstring, cleanErr := cleanSubstring(substring)
-					if cleanErr != nil {
-						errs = append(errs, cleanErr)
-						continue
-					}
-					cleanedSubstring = strings.ToLower(cleanedSubstring)
-					cleanedSubstring = strings.TrimSpace(cleanedSubstring)
-
-					if cleanedSubstring != "" {
-						tt.mu.Lock()
-						if _, ok := tt.Substrings[cleanedSubstring]; !ok {
-							tt.Substrings[cleanedSubstring] = new(atomic.Int32)
-						}
-						tt.Substrings[cleanedSubstring].Add(1)
-						tt.mu.Unlock()
+			tt.countWords(tt.tokens(sentence), state)
+		}(sentence)
+	}
+	wg.Wait()
+	if tt.metrics != nil {
+		tt.mu.Lock()
+		tt.metrics.Sentences += len(sentences)
+		tt.metrics.Goroutines += spawned
+		tt.mu.Unlock()
+	}
+}
+
+// finishParse drops substrings under Options.MinCount, records the clean failures of state and applies its
+// fused scores once every sentence is counted
+func (tt *Textee) finishParse(state *parseState, started time.Time) error {
+	tt.mu.Lock()
+	if tt.opts.MinCount > 1 {
+		for substring, quantity := range tt.Substrings {
+			if int(quantity.Load()) < tt.opts.MinCount {
+				tt.remove(substring)
+			}
+		}
+	}
+	tt.cleanFailures = make([]string, 0, len(state.cleanFailures))
+	for substring := range state.cleanFailures {
+		tt.cleanFailures = append(tt.cleanFailures, substring)
+	}
+	sort.Strings(tt.cleanFailures)
+	if tt.metrics != nil {
+		tt.metrics.ParseDuration += time.Since(started)
+	}
+	tt.mu.Unlock()
+	if err := state.strictCleanError(tt.opts); err != nil {
+		return err
+	}
+	if tt.opts.fused() {
+		sort.Slice(state.failed, func(i, j int) bool { return state.failed[i].Substring < state.failed[j].Substring })
+		tt.mu.Lock()
+		defer tt.mu.Unlock()
+		if tt.Gematrias == nil {
+			tt.Gematrias = make(map[string]gematria.Gematria)
+		}
+		state.dropUncounted(tt.Substrings)
+		if err := tt.applyGematrias(state.fused, state.failed); err != nil {
+			return errors.Join(ErrBadParsing, err)
+		}
+	}
+	return nil
+}
+
+// parseState is shared by the goroutines counting one parse and is guarded by tt.mu
+type parseState struct {
+	stop               map[string]bool
+	minNgram, maxNgram int
+	errs               []CleanError
+	cleanFailures      map[string]struct{}
+	fused              map[string]gematria.Gematria
+	failed             []FailedSubstring
+	created            []string
+	fuse               bool
+	discardSentences   bool // counted without being kept in tt.sentences, for streaming parses
+	ctx                context.Context
+}
+
+func (tt *Textee) newParseState() *parseState {
+	minNgram, maxNgram := tt.opts.ngramRange()
+	return &parseState{
+		stop:          tt.opts.stopwordSet(),
+		minNgram:      minNgram,
+		maxNgram:      maxNgram,
+		cleanFailures: make(map[string]struct{}),
+		fused:         make(map[string]gematria.Gematria),
+		fuse:          tt.opts.fused(),
+		ctx:           context.Background(),
+	}
+}
+
+// strictCleanError joins the clean errors of state when opts.StrictCleaning is set
+func (state *parseState) strictCleanError(opts Options) error {
+	if !opts.StrictCleaning {
+		return nil
+	}
+	var err error
+	for _, e := range state.errs {
+		err = errors.Join(err, e)
+	}
+	return err
+}
+
+// dropUncounted forgets the fused scores and failures of substrings that were evicted or pruned
+func (state *parseState) dropUncounted(substrings map[string]*atomic.Int32) {
+	for substring := range state.fused {
+		if _, ok := substrings[substring]; !ok {
+			delete(state.fused, substring)
+		}
+	}
+	state.failed = slices.DeleteFunc(state.failed, func(f FailedSubstring) bool {
+		_, ok := substrings[f.Substring]
+		return !ok
+	})
+}
+
+// countWords counts every n-gram window of words into tt.Substrings, recording the keys it creates in state
+// and scoring them as it goes with Options.FusedGematria
+func (tt *Textee) countWords(words []string, state *parseState) {
+	var local map[string]int
+	var localSurfaces map[string]map[string]int
+	if tt.opts.LocalCounting {
+		local = make(map[string]int)
+		localSurfaces = make(map[string]map[string]int)
+	}
+
+	for i := 0; i < len(words) && state.ctx.Err() == nil; i++ {
+		for j := i + state.minNgram; j <= i+state.maxNgram && j <= len(words); j++ {
+			substring := strings.Join(words[i:j], " ")
+			surface := substring
+			if state.stop != nil {
+				var keep bool
+				if substring, keep = tt.withoutStopwords(words[i:j], state.stop); !keep {
+					continue
+				}
+			}
+			cleanedSubstring, cleanErr := tt.normalize(substring)
+			if cleanErr != nil {
+				tt.mu.Lock()
+				state.errs = append(state.errs, cleanErr)
+				state.cleanFailures[substring] = struct{}{}
+				tt.mu.Unlock()
+				continue
+			}
+
+			if tt.opts.DropNumeric && isNumeric(cleanedSubstring) {
+				continue
+			}
+			if cleanedSubstring == "" || tt.hasShortWord(cleanedSubstring) {
+				continue
+			}
+			if local != nil {
+				local[cleanedSubstring]++
+				if tt.surfaces != nil {
+					if localSurfaces[cleanedSubstring] == nil {
+						localSurfaces[cleanedSubstring] = make(map[string]int)
 					}
+					localSurfaces[cleanedSubstring][surface]++
 				}
+				continue
 			}
-		}(sentence)
+			tt.mu.Lock()
+			created := tt.countSubstring(cleanedSubstring, 1)
+			if created {
+				state.created = append(state.created, cleanedSubstring)
+			}
+			if tt.surfaces != nil {
+				tt.recordSurface(cleanedSubstring, surface, 1)
+			}
+			tt.mu.Unlock()
+			if created {
+				tt.scoreNew(cleanedSubstring, state)
+			}
+		}
 	}
-	wg.Wait()
-	if len(errs) > 0 {
-		for _, e := range errs {
-			err = errors.Join(err, e)
+	if local == nil {
+		return
+	}
+	var created []string
+	tt.mu.Lock()
+	for substring, n := range local {
+		if tt.countSubstring(substring, n) {
+			created = append(created, substring)
+		}
+		for surface, count := range localSurfaces[substring] {
+			tt.recordSurface(substring, surface, count)
 		}
-		return nil, err
 	}
-	return tt, nil
+	state.created = append(state.created, created...)
+	tt.mu.Unlock()
+	for _, substring := range created {
+		tt.scoreNew(substring, state)
+	}
 }
 
-func (tt *Textee) String() string {
-	if len(tt.Substrings) == 0 {
-		return ""
+// hasShortWord reports whether a word of substring is shorter than Options.MinWordLength
+func (tt *Textee) hasShortWord(substring string) bool {
+	if tt.opts.MinWordLength <= 1 {
+		return false
 	}
-	hasGematria := len(tt.ScoresEnglish) > 0 || len(tt.ScoresJewish) > 0 || len(tt.ScoresSimple) > 0
+	for _, word := range strings.Fields(substring) {
+		if utf8.RuneCountInString(word) < tt.opts.MinWordLength {
+			return true
+		}
+	}
+	return false
+}
+
+// scoreNew scores a substring countWords just created into state when state.fuse is set
+func (tt *Textee) scoreNew(substring string, state *parseState) {
+	if !state.fuse {
+		return
+	}
+	gemscore, err := tt.score(substring)
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if err != nil {
+		state.failed = append(state.failed, FailedSubstring{Substring: substring, Err: errors.Join(ErrGematriaParse, err)})
+		return
+	}
+	state.fused[substring] = gemscore
+}
+
+// countSubstring adds n to the counter of substring, creating it when missing, and reports whether it did.
+// Callers must hold tt.mu.
+func (tt *Textee) countSubstring(substring string, n int) bool {
+	counter, ok := tt.Substrings[substring]
+	if !ok {
+		tt.makeRoom()
+		counter = new(atomic.Int32)
+		tt.Substrings[substring] = counter
+	}
+	counter.Add(int32(n))
+	return !ok
+}
+
+func (tt *Textee) String() string {
 	var output strings.Builder
-	for _, data := range tt.SortedSubstrings() {
-		if hasGematria := hasGematria; hasGematria {
-			output.WriteString(fmt.Sprintf("\"%v\": %d [English %d] [Jewish %d] [Simple %d] [Mystery %d] [Majestic %d] [Eights %d]\n",
+	_, _ = tt.WriteTo(&output)
+	return output.String()
+}
+
+// WriteTo streams the String() output to w one substring at a time, implementing io.WriterTo. Substrings
+// with a Gematrias entry are written with their scores and the others with their quantity alone. Input that
+// yields no substrings, such as punctuation alone, writes a "(no substrings)" line with the input's scores.
+func (tt *Textee) WriteTo(w io.Writer) (int64, error) {
+	tt.mu.RLock()
+	empty := len(tt.Substrings) == 0
+	input, gem := tt.Input, tt.Gematria
+	tt.mu.RUnlock()
+	if empty {
+		if strings.TrimSpace(input) == "" {
+			return 0, nil
+		}
+		n, err := fmt.Fprintf(w, "(no substrings) [English %d] [Jewish %d] [Simple %d] [Mystery %d] [Majestic %d] [Eights %d]\n",
+			gem.English, gem.Jewish, gem.Simple, gem.Mystery, gem.Majestic, gem.Eights)
+		return int64(n), err
+	}
+	var written int64
+	for _, data := range tt.sortedKeys() {
+		tt.mu.RLock()
+		gem, hasGematria := tt.Gematrias[data.Substring]
+		data.Substring = tt.display(data.Substring)
+		tt.mu.RUnlock()
+		var n int
+		var err error
+		if hasGematria {
+			n, err = fmt.Fprintf(w, "\"%v\": %d [English %d] [Jewish %d] [Simple %d] [Mystery %d] [Majestic %d] [Eights %d]\n",
 				data.Substring, data.Quantity,
-				tt.Gematrias[data.Substring].English,
-				tt.Gematrias[data.Substring].Jewish,
-				tt.Gematrias[data.Substring].Simple,
-				tt.Gematrias[data.Substring].Mystery,
-				tt.Gematrias[data.Substring].Majestic,
-				tt.Gematrias[data.Substring].Eights))
+				gem.English,
+				gem.Jewish,
+				gem.Simple,
+				gem.Mystery,
+				gem.Majestic,
+				gem.Eights)
 		} else {
-			output.WriteString(fmt.Sprintf("\"%v\": %d\n", data.Substring, data.Quantity))
+			n, err = fmt.Fprintf(w, "\"%v\": %d\n", data.Substring, data.Quantity)
+		}
+		written += int64(n)
+		if err != nil {
+			return written, err
 		}
 	}
-	return output.String()
+	return written, nil
 }
 
+// SortedSubstrings returns every substring with its quantity, most frequent first. With
+// Options.DominantSurface set each entry reports the most common original form of its substring.
 func (tt *Textee) SortedSubstrings() SortedStringQuantities {
+	sorted := tt.sortedKeys()
+	if tt.opts.DominantSurface {
+		tt.mu.RLock()
+		for i := range sorted {
+			sorted[i].Substring = tt.display(sorted[i].Substring)
+		}
+		tt.mu.RUnlock()
+	}
+	return sorted
+}
+
+// sortedKeys returns the stored substring keys with their quantities in SortedSubstrings order
+func (tt *Textee) sortedKeys() SortedStringQuantities {
 	tt.mu.RLock()
 	defer tt.mu.RUnlock()
 	var sortedQuantities SortedStringQuantities
@@ -133,29 +560,45 @@ func (tt *Textee) SortedSubstrings() SortedStringQuantities {
 	return sortedQuantities
 }
 
+// CalculateGematria scores every substring and rebuilds Gematrias and the Scores* maps. A substring that
+// fails to score is skipped and reported by FailedSubstrings while everything else is still populated, unless
+// Options.StrictGematria is set, in which case any failure returns an error. Either way tt is returned, on
+// error with Gematrias holding every substring that did score.
 func (tt *Textee) CalculateGematria() (*Textee, error) {
+	return tt, tt.calculateGematria(context.Background())
+}
+
+// calculateGematria implements CalculateGematria, leaving every score untouched when ctx is done first
+func (tt *Textee) calculateGematria(ctx context.Context) error {
 	tt.mu.Lock()
 	defer tt.mu.Unlock()
 	if tt.Gematrias == nil {
 		tt.Gematrias = make(map[string]gematria.Gematria)
 	}
-	substrings := tt.Substrings
+	if tt.metrics != nil {
+		defer func(started time.Time) { tt.metrics.GematriaDuration += time.Since(started) }(time.Now())
+	}
+	substrings := make([]string, 0, len(tt.Substrings))
+	for substring := range tt.Substrings {
+		substrings = append(substrings, substring)
+	}
+	gematrias, failed := tt.scoreAll(ctx, substrings)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return tt.applyGematrias(gematrias, failed)
+}
+
+// applyGematrias stores gematrias into Gematrias and rebuilds the sorted Scores* maps from them, recording
+// failed for FailedSubstrings and returning their errors under Options.StrictGematria. Callers must hold tt.mu.
+func (tt *Textee) applyGematrias(gematrias map[string]gematria.Gematria, failed []FailedSubstring) error {
 	englishResults := make(map[uint64][]string)
 	jewishResults := make(map[uint64][]string)
 	simpleResults := make(map[uint64][]string)
 	mysteryResults := make(map[uint64][]string)
 	majesticResults := make(map[uint64][]string)
 	eightsResults := make(map[uint64][]string)
-	errorCounter := atomic.Int32{}
-	errs := make([]error, 0)
-	for substring, _ := range substrings {
-		substring = strings.TrimSpace(substring)
-		gemscore, err := gematria.NewGematria(substring)
-		if err != nil {
-			errorCounter.Add(1)
-			errs = append(errs, errors.Join(ErrGematriaParse, err))
-			continue
-		}
+	for substring, gemscore := range gematrias {
 		englishResults[gemscore.English] = append(englishResults[gemscore.English], substring)
 		jewishResults[gemscore.Jewish] = append(jewishResults[gemscore.Jewish], substring)
 		simpleResults[gemscore.Simple] = append(simpleResults[gemscore.Simple], substring)
@@ -164,21 +607,231 @@ func (tt *Textee) CalculateGematria() (*Textee, error) {
 		eightsResults[gemscore.Eights] = append(eightsResults[gemscore.Eights], substring)
 		tt.Gematrias[substring] = gemscore
 	}
-	if errorCounter.Load() > 0 {
-		return nil, errors.Join(errs...)
+	tt.failed = failed
+	if err := tt.strictError(failed); err != nil {
+		return err
+	}
+	for _, results := range []map[uint64][]string{englishResults, jewishResults, simpleResults, mysteryResults, majesticResults, eightsResults} {
+		for _, bucket := range results {
+			sort.Strings(bucket)
+		}
 	}
-	substrings = nil
 	tt.ScoresEnglish = englishResults
 	tt.ScoresJewish = jewishResults
 	tt.ScoresSimple = simpleResults
 	tt.ScoresMystery = mysteryResults
 	tt.ScoresMajestic = majesticResults
 	tt.ScoresEights = eightsResults
-	englishResults = nil
-	jewishResults = nil
-	simpleResults = nil
-	mysteryResults = nil
-	majesticResults = nil
-	eightsResults = nil
+	tt.CustomScores = nil
+	for name, value := range tt.customCiphers() {
+		if tt.CustomScores == nil {
+			tt.CustomScores = make(map[string]map[uint64][]string)
+		}
+		results := make(map[uint64][]string)
+		for substring := range gematrias {
+			results[value(substring)] = append(results[value(substring)], substring)
+		}
+		for _, bucket := range results {
+			sort.Strings(bucket)
+		}
+		tt.CustomScores[name] = results
+	}
+	return nil
+}
+
+// customCiphers returns the Options.Ciphers that do not shadow a built-in Cipher
+func (tt *Textee) customCiphers() map[string]func(string) uint64 {
+	custom := make(map[string]func(string) uint64, len(tt.opts.Ciphers))
+	for name, value := range tt.opts.Ciphers {
+		if !Cipher(name).builtin() && value != nil {
+			custom[name] = value
+		}
+	}
+	return custom
+}
+
+// CalculateGematriaFor scores only those of substrings that are counted in Substrings but missing from
+// Gematrias, inserting each into its sorted Scores* buckets instead of rebuilding all six maps, so the result
+// matches a full CalculateGematria. Failures are handled as CalculateGematria handles them and tt is always
+// returned.
+func (tt *Textee) CalculateGematriaFor(substrings []string) (*Textee, error) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if tt.Gematrias == nil {
+		tt.Gematrias = make(map[string]gematria.Gematria)
+	}
+	pending := make([]string, 0, len(substrings))
+	seen := make(map[string]bool, len(substrings))
+	for _, substring := range substrings {
+		_, counted := tt.Substrings[substring]
+		_, scored := tt.Gematrias[substring]
+		if counted && !scored && !seen[substring] {
+			seen[substring] = true
+			pending = append(pending, substring)
+		}
+	}
+	gematrias, failed := tt.scoreAll(context.Background(), pending)
+
+	for _, cipher := range Ciphers() {
+		if tt.scores(cipher) == nil {
+			tt.setScores(cipher, make(map[uint64][]string))
+		}
+	}
+	custom := tt.customCiphers()
+	if len(custom) > 0 && tt.CustomScores == nil {
+		tt.CustomScores = make(map[string]map[uint64][]string)
+	}
+	for name := range custom {
+		if tt.CustomScores[name] == nil {
+			tt.CustomScores[name] = make(map[uint64][]string)
+		}
+	}
+	for substring, gemscore := range gematrias {
+		for _, cipher := range Ciphers() {
+			insertScore(tt.scores(cipher), cipher.Value(gemscore), substring)
+		}
+		for name, value := range custom {
+			insertScore(tt.CustomScores[name], value(substring), substring)
+		}
+		tt.Gematrias[substring] = gemscore
+	}
+	kept := failed
+	for _, f := range tt.failed {
+		if _, retried := seen[f.Substring]; !retried {
+			kept = append(kept, f)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Substring < kept[j].Substring })
+	tt.failed = kept
+	if err := tt.strictError(failed); err != nil {
+		return tt, err
+	}
 	return tt, nil
 }
+
+// scoreAll scores substrings across a pool of Options.Workers goroutines, returning the gematrias that
+// succeeded and the failures sorted alphabetically. It stops handing out substrings once ctx is done.
+func (tt *Textee) scoreAll(ctx context.Context, substrings []string) (map[string]gematria.Gematria, []FailedSubstring) {
+	workers := tt.opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(substrings) {
+		workers = len(substrings)
+	}
+	type partial struct {
+		gematrias map[string]gematria.Gematria
+		failed    []FailedSubstring
+	}
+	if tt.metrics != nil {
+		tt.metrics.Goroutines += workers
+	}
+	partials := make([]partial, workers)
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for w := range partials {
+		partials[w].gematrias = make(map[string]gematria.Gematria)
+		wg.Add(1)
+		go func(p *partial) {
+			defer wg.Done()
+			for substring := range jobs {
+				gemscore, err := tt.score(substring)
+				if err != nil {
+					p.failed = append(p.failed, FailedSubstring{Substring: substring, Err: errors.Join(ErrGematriaParse, err)})
+					continue
+				}
+				p.gematrias[substring] = gemscore
+			}
+		}(&partials[w])
+	}
+feed:
+	for _, substring := range substrings {
+		select {
+		case jobs <- substring:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	gematrias := make(map[string]gematria.Gematria, len(substrings))
+	var failed []FailedSubstring
+	for _, p := range partials {
+		failed = append(failed, p.failed...)
+		for substring, gemscore := range p.gematrias {
+			gematrias[substring] = gemscore
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].Substring < failed[j].Substring })
+	return gematrias, failed
+}
+
+// strictError joins the errors of failed when Options.StrictGematria is set and returns nil otherwise
+func (tt *Textee) strictError(failed []FailedSubstring) error {
+	if len(failed) == 0 || !tt.opts.StrictGematria {
+		return nil
+	}
+	errs := make([]error, len(failed))
+	for i, f := range failed {
+		errs[i] = f.Err
+	}
+	return errors.Join(errs...)
+}
+
+// insertScore adds substring to the bucket of value in scores, keeping the bucket sorted and free of duplicates
+func insertScore(scores map[uint64][]string, value uint64, substring string) {
+	bucket := scores[value]
+	i := sort.SearchStrings(bucket, substring)
+	if i < len(bucket) && bucket[i] == substring {
+		return
+	}
+	bucket = append(bucket, "")
+	copy(bucket[i+1:], bucket[i:])
+	bucket[i] = substring
+	scores[value] = bucket
+}
+
+// CleanFailures returns the raw n-grams the last ParseString could not clean and so skipped, sorted and
+// without duplicates
+func (tt *Textee) CleanFailures() []string {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return append([]string(nil), tt.cleanFailures...)
+}
+
+// FailedSubstrings returns the substrings the last CalculateGematria could not score, sorted alphabetically
+func (tt *Textee) FailedSubstrings() []FailedSubstring {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	return append([]FailedSubstring(nil), tt.failed...)
+}
+
+// GematriaOf scores s the way stored substrings are scored, normalizing it like Count first, stemming included,
+// and consulting the cached Gematrias before calculating
+func (tt *Textee) GematriaOf(s string) (gematria.Gematria, error) {
+	substring, err := tt.queryForm(s)
+	if err != nil {
+		return gematria.Gematria{}, errors.Join(ErrBadParsing, err)
+	}
+	tt.mu.RLock()
+	gem, ok := tt.Gematrias[substring]
+	tt.mu.RUnlock()
+	if ok {
+		return gem, nil
+	}
+	gem, err = tt.score(substring)
+	if err != nil {
+		return gematria.Gematria{}, errors.Join(ErrGematriaParse, err)
+	}
+	return gem, nil
+}
+
+// score calculates the gematria of substring, falling back to Options.GematriaFallback when it is rejected
+func (tt *Textee) score(substring string) (gematria.Gematria, error) {
+	gem, err := tt.opts.scoreGematria(substring)
+	if err != nil && tt.opts.GematriaFallback != nil {
+		return tt.opts.GematriaFallback(substring), nil
+	}
+	return gem, err
+}
@@ -1,7 +1,12 @@
 package textee
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/andreimerlescu/gematria"
@@ -59,12 +64,437 @@ func TestTextee_CalculateGematria(t *testing.T) {
 			t.Errorf("CalculateGematria() error = %v", err2)
 		}
 		want := gematria.Gematria{
-			Jewish:  337,
-			English: 702,
-			Simple:  117,
+			Jewish:   337,
+			English:  702,
+			Simple:   117,
+			Mystery:  5524,
+			Majestic: 351,
+			Eights:   809,
 		}
-		if !reflect.DeepEqual(got.Gematrias["manifesting"], want) {
+		if !sameGematria(got.Gematrias["manifesting"], want) {
 			t.Errorf("CalculateGematria() = %v, want %v", got, want)
 		}
 	})
 }
+
+func TestTextee_WriteTo(t *testing.T) {
+	tt, err := NewTextee("manifesting three six nine")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var output strings.Builder
+	n, err := tt.WriteTo(&output)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if n != int64(output.Len()) {
+		t.Errorf("WriteTo() = %d, wrote %d bytes", n, output.Len())
+	}
+	if !strings.Contains(output.String(), "\"manifesting\": 1 [English 702] [Jewish 337] [Simple 117]") {
+		t.Errorf("WriteTo() output is missing manifesting:\n%s", output.String())
+	}
+	if lines := strings.Count(output.String(), "\n"); lines != len(tt.Substrings) {
+		t.Errorf("WriteTo() wrote %d lines, want %d", lines, len(tt.Substrings))
+	}
+}
+
+func TestTextee_CalculateGematriaWorkers(t *testing.T) {
+	const input = "All right let's move from this point on 16 March 84, let's move in time to our second location."
+	serial, err := NewTexteeWithOptions(Options{Workers: 1}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	parallel, err := NewTexteeWithOptions(Options{Workers: 8}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if !serial.Equal(parallel) || !reflect.DeepEqual(serial.ScoresEnglish, parallel.ScoresEnglish) {
+		t.Error("CalculateGematria() results differ between 1 and 8 workers")
+	}
+}
+
+func benchmarkCalculateGematria(b *testing.B, workers int) {
+	var input strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&input, "Sentence number %d talks about item %d and value %d. ", i, i*7, i*13)
+	}
+	tt, err := NewTexteeWithOptions(Options{Workers: workers}, input.String())
+	if err != nil {
+		b.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tt.CalculateGematria(); err != nil {
+			b.Fatalf("CalculateGematria() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkCalculateGematria_Serial(b *testing.B)   { benchmarkCalculateGematria(b, 1) }
+func BenchmarkCalculateGematria_Parallel(b *testing.B) { benchmarkCalculateGematria(b, 0) }
+
+func TestTextee_StringPartialGematria(t *testing.T) {
+	tt, err := NewTextee("three six")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	delete(tt.Gematrias, "six")
+	output := tt.String()
+	if !strings.Contains(output, "\"three\": 1 [English 336]") {
+		t.Errorf("String() should score three:\n%s", output)
+	}
+	if !strings.Contains(output, "\"six\": 1\n") {
+		t.Errorf("String() should print six without scores:\n%s", output)
+	}
+}
+
+func TestTextee_FailedSubstrings(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{scorer: failGematriaFor("bad")}, "good bad")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	failed := tt.FailedSubstrings()
+	if len(failed) != 1 || failed[0].Substring != "bad" || !errors.Is(failed[0].Err, ErrGematriaParse) {
+		t.Fatalf("FailedSubstrings() = %v, want bad failing with %v", failed, ErrGematriaParse)
+	}
+	if _, ok := tt.Gematrias["bad"]; ok {
+		t.Error("Gematrias should not contain the failed substring")
+	}
+	if tt.Substrings["bad"] == nil {
+		t.Error("Substrings should still count the failed substring")
+	}
+	for _, substring := range []string{"good", "good bad"} {
+		if _, ok := tt.Gematrias[substring]; !ok {
+			t.Errorf("Gematrias[%q] missing after another substring failed", substring)
+		}
+	}
+	if got := tt.ScoresEnglish[tt.Gematrias["good"].English]; len(got) == 0 {
+		t.Error("ScoresEnglish should be populated for the substrings that scored")
+	}
+}
+
+func TestTextee_CalculateGematriaSortedBuckets(t *testing.T) {
+	// "abc" and "cba" share every cipher value, so each bucket holds both
+	const input = "abc cba bca. cab acb bac abc."
+	first, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	want, err := first.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	for _, cipher := range Ciphers() {
+		for value, bucket := range first.scores(cipher) {
+			if !sort.StringsAreSorted(bucket) {
+				t.Errorf("Scores %s[%d] = %v, want it sorted", cipher, value, bucket)
+			}
+		}
+	}
+	for i := 0; i < 20; i++ {
+		again, err := NewTextee(input)
+		if err != nil {
+			t.Fatalf("NewTextee() error = %v", err)
+		}
+		for _, cipher := range Ciphers() {
+			if !reflect.DeepEqual(again.scores(cipher), first.scores(cipher)) {
+				t.Fatalf("Scores %s differ between parses of the same text", cipher)
+			}
+		}
+		if got, _ := again.MarshalJSON(); string(got) != string(want) {
+			t.Fatal("MarshalJSON() output differs between parses of the same text")
+		}
+	}
+}
+
+func TestTextee_CalculateGematriaFor(t *testing.T) {
+	full, err := NewTextee("abc cba bca. the dog ran.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+
+	incremental, err := NewTextee("abc cba bca.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	var added []string
+	for substring, quantity := range full.Substrings {
+		if _, ok := incremental.Substrings[substring]; !ok {
+			incremental.Substrings[substring] = quantity
+			added = append(added, substring)
+		}
+	}
+	if _, err := incremental.CalculateGematriaFor(append(added, "abc", "missing")); err != nil {
+		t.Fatalf("CalculateGematriaFor() error = %v", err)
+	}
+	if len(incremental.Gematrias) != len(full.Gematrias) {
+		t.Errorf("CalculateGematriaFor() scored %d substrings, want %d", len(incremental.Gematrias), len(full.Gematrias))
+	}
+	for _, cipher := range Ciphers() {
+		if !reflect.DeepEqual(incremental.scores(cipher), full.scores(cipher)) {
+			t.Errorf("Scores %s = %v, want %v", cipher, incremental.scores(cipher), full.scores(cipher))
+		}
+	}
+}
+
+func TestTextee_StringNoSubstrings(t *testing.T) {
+	tt, err := NewTextee("?! ... !!!")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if len(tt.Substrings) != 0 {
+		t.Fatalf("Substrings = %v, want none for punctuation", tt.Substrings)
+	}
+	if output := tt.String(); !strings.HasPrefix(output, "(no substrings) [English 0]") {
+		t.Errorf("String() = %q, want the no substrings marker", output)
+	}
+	if output := (&Textee{}).String(); output != "" {
+		t.Errorf("String() without input = %q, want empty", output)
+	}
+}
+
+func TestOptions_FusedGematria(t *testing.T) {
+	const input = "abc cba bca. The dog ran. The dog sat!"
+	twoPass, err := NewTextee(input)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	fused, err := NewTexteeWithOptions(Options{FusedGematria: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if !fused.Equal(twoPass) {
+		t.Error("FusedGematria results differ from the two-pass path")
+	}
+	for _, cipher := range Ciphers() {
+		if !reflect.DeepEqual(fused.scores(cipher), twoPass.scores(cipher)) {
+			t.Errorf("Scores %s = %v, want %v", cipher, fused.scores(cipher), twoPass.scores(cipher))
+		}
+	}
+}
+
+func benchmarkNewTextee(b *testing.B, opts Options) {
+	var input strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&input, "Sentence number %d talks about item %d and value %d. ", i, i*7, i*13)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewTexteeWithOptions(opts, input.String()); err != nil {
+			b.Fatalf("NewTexteeWithOptions() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkNewTextee_TwoPass(b *testing.B) { benchmarkNewTextee(b, Options{}) }
+func BenchmarkNewTextee_Fused(b *testing.B)   { benchmarkNewTextee(b, Options{FusedGematria: true}) }
+
+func TestTextee_ErrorsReturnReceiver(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{StrictGematria: true, MaxInputBytes: 20}, "good bad")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	tt.opts.scorer = failGematriaFor("bad")
+	got, err := tt.CalculateGematria()
+	if !errors.Is(err, ErrGematriaParse) || got != tt {
+		t.Errorf("CalculateGematria() = %p, %v, want the receiver %p and %v", got, err, tt, ErrGematriaParse)
+	}
+	if _, ok := got.Gematrias["good"]; !ok {
+		t.Error("CalculateGematria() should keep the substrings that scored")
+	}
+	got, err = tt.ParseString(strings.Repeat("x", 21))
+	if !errors.Is(err, ErrInputTooLarge) || got != tt {
+		t.Errorf("ParseString() = %p, %v, want the receiver %p and %v", got, err, tt, ErrInputTooLarge)
+	}
+}
+
+func TestTextee_GematriasKeysMatchSubstrings(t *testing.T) {
+	tt, err := NewTextee("  Hello,   World!  It's 2024... \"Quoted\" words & more. ")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if len(tt.Gematrias) != len(tt.Substrings) {
+		t.Fatalf("Gematrias has %d keys, Substrings has %d", len(tt.Gematrias), len(tt.Substrings))
+	}
+	for substring := range tt.Substrings {
+		gem, ok := tt.Gematrias[substring]
+		if !ok {
+			t.Errorf("Gematrias[%q] missing", substring)
+			continue
+		}
+		if want := gematria.FromString(substring); !sameGematria(gem, want) {
+			t.Errorf("Gematrias[%q] = %v, want the stored key scored as is %v", substring, gem, want)
+		}
+	}
+}
+
+func TestOptions_LocalCounting(t *testing.T) {
+	const input = "The dog ran. The dog sat! The Dog ran? a b c d e f."
+	shared, err := NewTexteeWithOptions(Options{DominantSurface: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	local, err := NewTexteeWithOptions(Options{DominantSurface: true, LocalCounting: true, FusedGematria: true}, input)
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if !local.Equal(shared) {
+		t.Error("LocalCounting results differ from the shared counters")
+	}
+	if !reflect.DeepEqual(local.SortedSubstrings(), shared.SortedSubstrings()) {
+		t.Errorf("SortedSubstrings() = %v, want %v", local.SortedSubstrings(), shared.SortedSubstrings())
+	}
+	if !reflect.DeepEqual(local.SurfaceForms("the dog"), shared.SurfaceForms("the dog")) {
+		t.Errorf("SurfaceForms(the dog) = %v, want %v", local.SurfaceForms("the dog"), shared.SurfaceForms("the dog"))
+	}
+}
+
+func benchmarkParseString(b *testing.B, opts Options) {
+	var input strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&input, "Sentence number %d talks about item %d and the value %d again and again. ", i, i%50, i%70)
+	}
+	tt := &Textee{opts: opts}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tt.ParseString(input.String()); err != nil {
+			b.Fatalf("ParseString() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkParseString_SharedCounters(b *testing.B) { benchmarkParseString(b, Options{}) }
+func BenchmarkParseString_LocalCounting(b *testing.B) {
+	benchmarkParseString(b, Options{LocalCounting: true})
+}
+
+func TestNewTextee_EmptyInput(t *testing.T) {
+	tests := map[string][]string{
+		"no arguments":     {},
+		"nil":              nil,
+		"single empty":     {""},
+		"all whitespace":   {" \t\n "},
+		"slice of empties": {"", "", ""},
+		"empty and spaces": {"", "  ", " "},
+	}
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt, err := NewTextee(in...); !errors.Is(err, ErrEmptyInput) || tt != nil {
+				t.Errorf("NewTextee(%q) = %v, %v, want nil and %v", in, tt, err, ErrEmptyInput)
+			}
+		})
+	}
+	if _, err := NewTextee("", "word"); err != nil {
+		t.Errorf("NewTextee() with one non-empty part error = %v", err)
+	}
+}
+
+func TestTextee_CleanFailures(t *testing.T) {
+	tt, err := NewTextee("red fish.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	tt.opts.cleaner = func(string) (string, error) { return "", ErrRegexpMissing }
+
+	if _, err := tt.ParseString("red fish."); err != nil {
+		t.Fatalf("ParseString() error = %v, want clean failures skipped", err)
+	}
+	if got, want := tt.CleanFailures(), []string{"fish.", "red", "red fish."}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CleanFailures() = %q, want %q", got, want)
+	}
+	if len(tt.Substrings) != 0 {
+		t.Errorf("Substrings = %v, want the failed n-grams skipped", tt.Substrings)
+	}
+
+	tt.opts.StrictCleaning = true
+	if _, err := tt.ParseString("red fish."); !errors.Is(err, ErrRegexpMissing) {
+		t.Errorf("ParseString() with StrictCleaning error = %v, want %v", err, ErrRegexpMissing)
+	}
+}
+
+func TestTextee_InputGematria(t *testing.T) {
+	tt, err := NewTexteeWithOptions(Options{StripHTML: true}, "<p>Hello, World!</p>   It's 3 o'clock.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	want, err := gematria.NewGematria("hello world its 3 oclock")
+	if err != nil {
+		t.Fatalf("NewGematria() error = %v", err)
+	}
+	if got := tt.InputGematria(); got != want {
+		t.Errorf("InputGematria() = %+v, want %+v scored from the cleaned words", got, want)
+	}
+
+	single, err := NewTextee("Light.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if got, want := single.InputGematria(), single.Gematrias["light"]; got != want {
+		t.Errorf("InputGematria() = %+v, want the score of its only substring %+v", got, want)
+	}
+}
+
+func TestNewTexteeContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewTexteeContext(ctx, "One sentence. Another sentence."); !errors.Is(err, context.Canceled) || !errors.Is(err, ErrBadParsing) {
+		t.Errorf("NewTexteeContext() error = %v, want %v joined with %v", err, ErrBadParsing, context.Canceled)
+	}
+
+	tt, err := NewTexteeContext(context.Background(), "One sentence. Another sentence.")
+	if err != nil {
+		t.Fatalf("NewTexteeContext() error = %v", err)
+	}
+	if len(tt.Gematrias) != len(tt.Substrings) || len(tt.Substrings) == 0 {
+		t.Errorf("NewTexteeContext() scored %d of %d substrings, want all", len(tt.Gematrias), len(tt.Substrings))
+	}
+
+	if _, err := tt.ParseStringContext(ctx, "Fresh input here."); !errors.Is(err, context.Canceled) {
+		t.Errorf("ParseStringContext() error = %v, want %v", err, context.Canceled)
+	}
+	if len(tt.Substrings) != 0 {
+		t.Errorf("ParseStringContext() counted %d substrings after cancellation, want 0", len(tt.Substrings))
+	}
+}
+
+func TestTextee_AppendString(t *testing.T) {
+	tt, err := NewTextee("The light of day.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, err := tt.AppendString("The light of night."); err != nil {
+		t.Fatalf("AppendString() error = %v", err)
+	}
+	if got := tt.Count("the light of"); got != 2 {
+		t.Errorf("Count(the light of) = %d after AppendString, want 2", got)
+	}
+
+	want, err := NewTextee("The light of day.", "The light of night.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if !reflect.DeepEqual(tt.SortedSubstrings(), want.SortedSubstrings()) {
+		t.Error("AppendString() counts differ from parsing both inputs together")
+	}
+	for _, cipher := range Ciphers() {
+		if !reflect.DeepEqual(tt.ScoresFor(cipher), want.ScoresFor(cipher)) {
+			t.Errorf("AppendString() %s scores differ from parsing both inputs together", cipher)
+		}
+	}
+	if !sameGematria(tt.InputGematria(), want.InputGematria()) {
+		t.Errorf("InputGematria() = %+v, want %+v", tt.InputGematria(), want.InputGematria())
+	}
+
+	counting, err := NewTexteeWithOptions(Options{SkipGematria: true}, "First part.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	if _, err := counting.AppendString("Second part."); err != nil {
+		t.Fatalf("AppendString() error = %v", err)
+	}
+	if counting.Count("part") != 2 || len(counting.Gematrias) != 0 {
+		t.Errorf("AppendString() with SkipGematria counted part %d times and scored %d substrings, want 2 and 0",
+			counting.Count("part"), len(counting.Gematrias))
+	}
+}
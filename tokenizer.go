@@ -0,0 +1,210 @@
+package textee
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/andreimerlescu/gematria"
+)
+
+// Tokenizer splits a sentence into the words n-grams are built from. Tokens are cleaned and lowercased after
+// tokenizing, so a Tokenizer only decides where one word ends and the next begins.
+type Tokenizer interface {
+	Tokenize(sentence string) []string
+}
+
+// FieldsTokenizer is the default Tokenizer, it splits sentences on whitespace with strings.Fields
+type FieldsTokenizer struct{}
+
+// Tokenize implements Tokenizer
+func (FieldsTokenizer) Tokenize(sentence string) []string {
+	return strings.Fields(sentence)
+}
+
+// TokenizerFunc adapts a plain function into a Tokenizer
+type TokenizerFunc func(sentence string) []string
+
+// Tokenize implements Tokenizer
+func (f TokenizerFunc) Tokenize(sentence string) []string {
+	return f(sentence)
+}
+
+// tokens splits sentence with the configured Tokenizer and prepares the result with prepareTokens
+func (tt *Textee) tokens(sentence string) []string {
+	var tokenizer Tokenizer = FieldsTokenizer{}
+	if tt.opts.Tokenizer != nil {
+		tokenizer = tt.opts.Tokenizer
+	}
+	return tt.prepareTokens(tokenizer.Tokenize(sentence))
+}
+
+// prepareTokens applies Options.NumberWords and Options.Stemmer to words when set. Tokens made only of
+// punctuation, such as a lone "&" or an ellipsis, are dropped so they never leave a double space inside an
+// n-gram or let one span them as an empty word.
+func (tt *Textee) prepareTokens(words []string) []string {
+	if tt.opts.NumberWords {
+		folded := make([]string, 0, len(words))
+		for _, word := range words {
+			if spelled := foldNumber(word); spelled != nil {
+				folded = append(folded, spelled...)
+				continue
+			}
+			folded = append(folded, word)
+		}
+		words = folded
+	}
+	if tt.opts.Stemmer == nil {
+		kept := make([]string, 0, len(words))
+		for _, word := range words {
+			if cleaned, err := tt.opts.clean(word); err != nil || strings.TrimSpace(cleaned) != "" {
+				kept = append(kept, word)
+			}
+		}
+		return kept
+	}
+	stemmed := make([]string, 0, len(words))
+	for _, word := range words {
+		word, err := tt.normalize(word)
+		if err != nil || word == "" {
+			continue
+		}
+		if word = tt.opts.Stemmer(word); word != "" {
+			stemmed = append(stemmed, word)
+		}
+	}
+	return stemmed
+}
+
+// NewTexteeFromTokens counts and scores tokens like NewTextee counts a single sentence, see ParseTokens
+func NewTexteeFromTokens(tokens []string) (*Textee, error) {
+	return NewTexteeFromTokensWithOptions(Options{}, tokens)
+}
+
+// NewTexteeFromTokensWithOptions counts and scores tokens using the behavior configured by opts. Tokens that are
+// all blank, including none at all, return ErrEmptyInput.
+func NewTexteeFromTokensWithOptions(opts Options, tokens []string) (*Textee, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	input := strings.Join(tokens, " ")
+	if strings.TrimSpace(input) == "" {
+		return nil, ErrEmptyInput
+	}
+	if opts.MaxInputBytes > 0 && len(input) > opts.MaxInputBytes {
+		return nil, ErrInputTooLarge
+	}
+	tt := newTextee(opts, "", gematria.Gematria{})
+	sentence, segments := tt.redactTokens(tokens)
+	tt.Input = tt.unmark(sentence)
+	tt.startMetrics()
+	words, err := tt.parseTokens(sentence, segments)
+	if err != nil {
+		return nil, errors.Join(ErrBadParsing, err)
+	}
+	var cleaned []string
+	for _, word := range words {
+		if word, err := tt.normalize(word); err == nil && word != "" {
+			cleaned = append(cleaned, word)
+		}
+	}
+	if tt.Gematria, err = tt.scoreWords(cleaned); err != nil {
+		return nil, err
+	}
+	if !opts.FusedGematria && !opts.SkipGematria {
+		if _, err := tt.CalculateGematria(); err != nil {
+			return nil, errors.Join(ErrBadParsing, err)
+		}
+	}
+	tt.reportMetrics()
+	return tt, nil
+}
+
+// ParseTokens counts the n-grams of tokens, taken as the words of one sentence, on top of the existing
+// Substrings. It skips the Tokenizer, the sentence splitter and every preprocessing option, but redacts each
+// token with Options.Redact and RedactPattern so no n-gram spans a redacted token, and cleans, stems and filters
+// each n-gram just like ParseString. New substrings are scored before it returns when Options.FusedGematria is
+// set and otherwise wait for CalculateGematria or CalculateGematriaFor.
+func (tt *Textee) ParseTokens(tokens []string) error {
+	if tt.opts.MaxInputBytes > 0 && len(strings.Join(tokens, " ")) > tt.opts.MaxInputBytes {
+		return ErrInputTooLarge
+	}
+	_, err := tt.parseTokens(tt.redactTokens(tokens))
+	return err
+}
+
+// redactTokens redacts every token and returns them joined by spaces, as the sentence ParseTokens records,
+// along with the runs of tokens left between redacted text
+func (tt *Textee) redactTokens(tokens []string) (string, [][]string) {
+	if !tt.opts.redacting() {
+		return strings.Join(tokens, " "), [][]string{tokens}
+	}
+	redacted := make([]string, len(tokens))
+	var segments [][]string
+	var current []string
+	for i, token := range tokens {
+		redacted[i] = tt.redact(token)
+		for j, part := range strings.Split(redacted[i], redactSentinel) {
+			if j > 0 {
+				segments = append(segments, current)
+				current = nil
+			}
+			if part != "" {
+				current = append(current, part)
+			}
+		}
+	}
+	return strings.Join(redacted, " "), append(segments, current)
+}
+
+// parseTokens counts the n-grams of each segment for ParseTokens, recording sentence, and returns the prepared
+// words of every segment
+func (tt *Textee) parseTokens(sentence string, segments [][]string) ([]string, error) {
+	prepared := make([][]string, len(segments))
+	var words []string
+	for i, segment := range segments {
+		prepared[i] = tt.prepareTokens(segment)
+		words = append(words, prepared[i]...)
+	}
+	tt.mu.Lock()
+	var started time.Time
+	if tt.metrics != nil {
+		started = time.Now()
+	}
+	if tt.Substrings == nil {
+		tt.Substrings = make(map[string]*atomic.Int32)
+	}
+	if tt.surfaces == nil && (tt.opts.SurfaceForms || tt.opts.DominantSurface) {
+		tt.surfaces = make(map[string]map[string]int)
+	}
+	if len(words) > 0 {
+		tt.sentences = append(tt.sentences, sentence)
+	}
+	tt.mu.Unlock()
+
+	state := tt.newParseState()
+	state.fuse = false
+	for _, segment := range prepared {
+		tt.countWords(segment, state)
+	}
+
+	tt.mu.Lock()
+	tt.addCleanFailures(state)
+	if tt.metrics != nil {
+		tt.metrics.ParseDuration += time.Since(started)
+		if len(words) > 0 {
+			tt.metrics.Sentences++
+		}
+	}
+	tt.mu.Unlock()
+	if err := state.strictCleanError(tt.opts); err != nil {
+		return words, err
+	}
+	if tt.opts.fused() {
+		if _, err := tt.CalculateGematriaFor(state.created); err != nil {
+			return words, errors.Join(ErrBadParsing, err)
+		}
+	}
+	return words, nil
+}
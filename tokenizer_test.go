@@ -0,0 +1,160 @@
+package textee
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOptions_Tokenizer(t *testing.T) {
+	hyphens := TokenizerFunc(func(sentence string) []string {
+		return strings.FieldsFunc(sentence, func(r rune) bool { return r == ' ' || r == '-' })
+	})
+	tt, err := NewTexteeWithOptions(Options{Tokenizer: hyphens}, "A well-known fact.")
+	if err != nil {
+		t.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	for _, substring := range []string{"well", "known", "well known", "a well known"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing with a hyphen tokenizer", substring)
+		}
+	}
+	if _, ok := tt.Substrings["wellknown"]; ok {
+		t.Error("Substrings should not contain wellknown with a hyphen tokenizer")
+	}
+
+	plain, err := NewTextee("A well-known fact.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	if _, ok := plain.Substrings["wellknown"]; !ok {
+		t.Error("FieldsTokenizer should keep well-known as one token")
+	}
+}
+
+func TestTextee_PunctuationTokens(t *testing.T) {
+	tt, err := NewTextee("Fish & chips - then more fish. I like fish! Fish, again?")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	for substring := range tt.Substrings {
+		if strings.Contains(substring, "  ") || substring != strings.TrimSpace(substring) {
+			t.Errorf("Substrings contains the malformed key %q", substring)
+		}
+	}
+	if got := tt.Substrings["fish"].Load(); got != 4 {
+		t.Errorf("Substrings[fish] = %d, want mid-sentence and sentence-final uses aggregated into 4", got)
+	}
+	for _, substring := range []string{"fish chips", "chips then", "fish chips then"} {
+		if _, ok := tt.Substrings[substring]; !ok {
+			t.Errorf("Substrings[%q] missing", substring)
+		}
+	}
+}
+
+func TestTextee_ParseTokens(t *testing.T) {
+	tt, err := NewTexteeFromTokens([]string{"New", "York.", "City"})
+	if err != nil {
+		t.Fatalf("NewTexteeFromTokens() error = %v", err)
+	}
+	for _, substring := range []string{"new york", "york city", "new york city"} {
+		if tt.Count(substring) != 1 {
+			t.Errorf("Count(%q) = %d, want 1 with the tokens taken as one sentence", substring, tt.Count(substring))
+		}
+		if _, ok := tt.Gematrias[substring]; !ok {
+			t.Errorf("Gematrias[%q] missing after NewTexteeFromTokens", substring)
+		}
+	}
+
+	if err := tt.ParseTokens([]string{"new", "york"}); err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	if got := tt.Count("new york"); got != 2 {
+		t.Errorf("Count(new york) = %d after ParseTokens, want 2", got)
+	}
+
+	if _, err := NewTexteeFromTokens([]string{" ", ""}); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("NewTexteeFromTokens(blank) error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestTextee_ParseTokensRedact(t *testing.T) {
+	tt, err := NewTexteeFromTokensWithOptions(Options{Redact: []string{"alice"}}, []string{"alice", "met", "bob"})
+	if err != nil {
+		t.Fatalf("NewTexteeFromTokensWithOptions() error = %v", err)
+	}
+	if want := "[REDACTED] met bob"; tt.Input != want {
+		t.Errorf("Input = %q, want %q", tt.Input, want)
+	}
+	if err := tt.ParseTokens([]string{"bob", "and", "Alice"}); err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	leaks := func(s string) bool { return strings.Contains(s, "alice") || strings.Contains(s, "redacted") }
+	for substring := range tt.Substrings {
+		if leaks(substring) {
+			t.Errorf("Substrings contains redacted %q", substring)
+		}
+	}
+	for substring := range tt.Gematrias {
+		if leaks(substring) {
+			t.Errorf("Gematrias contains redacted %q", substring)
+		}
+	}
+	for _, cipher := range Ciphers() {
+		for _, bucket := range tt.scores(cipher) {
+			for _, substring := range bucket {
+				if leaks(substring) {
+					t.Errorf("Scores %s contains redacted %q", cipher, substring)
+				}
+			}
+		}
+	}
+	for _, sentence := range tt.Sentences() {
+		if strings.Contains(strings.ToLower(sentence), "alice") {
+			t.Errorf("Sentences() contains redacted %q", sentence)
+		}
+	}
+	if tt.Count("met bob") != 1 || tt.Count("bob and") != 1 {
+		t.Errorf("Substrings = %v, want met bob and bob and counted", tt.SortedSubstrings())
+	}
+	if !sameGematria(tt.InputGematria(), tt.Gematrias["met bob"]) {
+		t.Errorf("InputGematria() = %+v, want the score of met bob alone", tt.InputGematria())
+	}
+}
+
+func TestTextee_ParseTokensStemmedSentence(t *testing.T) {
+	tt, err := NewTexteeFromTokensWithOptions(Options{Stemmer: SuffixStemmer}, []string{"meetings", "today"})
+	if err != nil {
+		t.Fatalf("NewTexteeFromTokensWithOptions() error = %v", err)
+	}
+	if tt.Count("meetings") != 1 {
+		t.Errorf("Count(meetings) = %d, want 1", tt.Count("meetings"))
+	}
+	if got := tt.SentenceTF("meetings"); got != 1 {
+		t.Errorf("SentenceTF(meetings) = %v, want 1 with the stemmer applied once", got)
+	}
+}
+
+func TestTextee_ParseTokensFused(t *testing.T) {
+	tt, err := NewTexteeFromTokensWithOptions(Options{FusedGematria: true}, []string{"light", "of", "day"})
+	if err != nil {
+		t.Fatalf("NewTexteeFromTokensWithOptions() error = %v", err)
+	}
+	if err := tt.ParseTokens([]string{"light", "years"}); err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	full, err := NewTexteeFromTokens([]string{"light", "of", "day"})
+	if err != nil {
+		t.Fatalf("NewTexteeFromTokens() error = %v", err)
+	}
+	if err := full.ParseTokens([]string{"light", "years"}); err != nil {
+		t.Fatalf("ParseTokens() error = %v", err)
+	}
+	if _, err := full.CalculateGematria(); err != nil {
+		t.Fatalf("CalculateGematria() error = %v", err)
+	}
+	if !reflect.DeepEqual(tt.ScoresEnglish, full.ScoresEnglish) {
+		t.Errorf("fused ScoresEnglish = %v, want %v", tt.ScoresEnglish, full.ScoresEnglish)
+	}
+}
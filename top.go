@@ -0,0 +1,96 @@
+package textee
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopN returns the n most frequent substrings in SortedSubstrings order. It keeps only n entries in a bounded
+// heap while scanning, so it stays cheap when n is far smaller than Substrings.
+func (tt *Textee) TopN(n int) SortedStringQuantities {
+	top := SortedStringQuantities{}
+	if n <= 0 {
+		return top
+	}
+	h := &boundedHeap[SubstringQuantity]{better: func(a, b SubstringQuantity) bool {
+		return SortedStringQuantities{a, b}.Less(0, 1)
+	}}
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+	for substring, quantity := range tt.Substrings {
+		h.offer(SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())}, n)
+	}
+	top = append(top, h.sorted()...)
+	if tt.opts.DominantSurface {
+		for i := range top {
+			top[i].Substring = tt.display(top[i].Substring)
+		}
+	}
+	return top
+}
+
+// TopNByScore returns the n substrings with the highest values under cipher, matching the start of
+// SortedByScore(cipher, false), while holding only n entries at a time
+func (tt *Textee) TopNByScore(cipher Cipher, n int) []SubstringQuantity {
+	top := []SubstringQuantity{}
+	if n <= 0 {
+		return top
+	}
+	type scored struct {
+		SubstringQuantity
+		value uint64
+	}
+	h := &boundedHeap[scored]{better: func(a, b scored) bool {
+		if a.value != b.value {
+			return a.value > b.value
+		}
+		return a.Substring < b.Substring
+	}}
+	tt.mu.RLock()
+	for substring, quantity := range tt.Substrings {
+		h.offer(scored{SubstringQuantity{Substring: substring, Quantity: int(quantity.Load())}, tt.valueOf(cipher, substring)}, n)
+	}
+	tt.mu.RUnlock()
+	for _, entry := range h.sorted() {
+		top = append(top, entry.SubstringQuantity)
+	}
+	return top
+}
+
+// boundedHeap keeps the best items offered to it, holding the worst of those kept at its root
+type boundedHeap[T any] struct {
+	items  []T
+	better func(a, b T) bool
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.items) }
+func (h *boundedHeap[T]) Less(i, j int) bool { return h.better(h.items[j], h.items[i]) }
+func (h *boundedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+// Push is part of heap.Interface.
+func (h *boundedHeap[T]) Push(x any) { h.items = append(h.items, x.(T)) }
+
+// Pop is part of heap.Interface.
+func (h *boundedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// offer adds item when fewer than n are held or when it beats the worst one held, which it then replaces
+func (h *boundedHeap[T]) offer(item T, n int) {
+	if len(h.items) < n {
+		heap.Push(h, item)
+		return
+	}
+	if h.better(item, h.items[0]) {
+		h.items[0] = item
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted returns the items held, best first
+func (h *boundedHeap[T]) sorted() []T {
+	sort.Slice(h.items, func(i, j int) bool { return h.better(h.items[i], h.items[j]) })
+	return h.items
+}
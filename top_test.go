@@ -0,0 +1,65 @@
+package textee
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTextee_TopN(t *testing.T) {
+	tt, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	sorted := tt.SortedSubstrings()
+	for _, n := range []int{1, 5, len(sorted), len(sorted) + 10} {
+		want := sorted[:min(n, len(sorted))]
+		if got := tt.TopN(n); !reflect.DeepEqual(got, want) {
+			t.Errorf("TopN(%d) = %v, want %v", n, got, want)
+		}
+	}
+	if got := tt.TopN(0); len(got) != 0 {
+		t.Errorf("TopN(0) = %v, want none", got)
+	}
+}
+
+func TestTextee_TopNByScore(t *testing.T) {
+	tt, err := NewTextee(encodingInput)
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+	for _, cipher := range Ciphers() {
+		sorted := tt.SortedByScore(cipher, false)
+		for _, n := range []int{1, 4, len(sorted) + 1} {
+			want := sorted[:min(n, len(sorted))]
+			if got := tt.TopNByScore(cipher, n); !reflect.DeepEqual(got, want) {
+				t.Errorf("TopNByScore(%s, %d) = %v, want %v", cipher, n, got, want)
+			}
+		}
+	}
+	if got := tt.TopNByScore(CipherEnglish, -1); len(got) != 0 {
+		t.Errorf("TopNByScore(-1) = %v, want none", got)
+	}
+}
+
+func BenchmarkTextee_TopN(b *testing.B) {
+	var input strings.Builder
+	for i := 0; i < 20000; i++ {
+		fmt.Fprintf(&input, "Sentence number %d talks about item %d and value %d. ", i, i*7, i*13)
+	}
+	tt, err := NewTexteeWithOptions(Options{SkipGematria: true}, input.String())
+	if err != nil {
+		b.Fatalf("NewTexteeWithOptions() error = %v", err)
+	}
+	b.Run("TopN", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = tt.TopN(20)
+		}
+	})
+	b.Run("SortedSubstrings", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = tt.SortedSubstrings()[:20]
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package textee
+
+import (
+	"sort"
+	"strings"
+)
+
+// WeightedSubstring is a substring with its raw count and that count multiplied by its length weight
+type WeightedSubstring struct {
+	Substring string  `json:"s"`
+	Quantity  int     `json:"q"`
+	Score     float64 `json:"w"`
+}
+
+// WeightedSubstrings ranks substrings by their count multiplied by weights[n], where n is the number of words
+// in the substring, so longer and more informative n-grams can outrank frequent unigrams. Lengths missing from
+// weights count with 1.0, so a nil map reproduces the SortedSubstrings order. Ties are broken alphabetically.
+func (tt *Textee) WeightedSubstrings(weights map[int]float64) []WeightedSubstring {
+	tt.mu.RLock()
+	weighted := make([]WeightedSubstring, 0, len(tt.Substrings))
+	for substring, quantity := range tt.Substrings {
+		q := int(quantity.Load())
+		weight, ok := weights[len(strings.Fields(substring))]
+		if !ok {
+			weight = 1
+		}
+		weighted = append(weighted, WeightedSubstring{Substring: substring, Quantity: q, Score: float64(q) * weight})
+	}
+	tt.mu.RUnlock()
+	sort.Slice(weighted, func(i, j int) bool {
+		if weighted[i].Score != weighted[j].Score {
+			return weighted[i].Score > weighted[j].Score
+		}
+		return weighted[i].Substring < weighted[j].Substring
+	})
+	return weighted
+}
@@ -0,0 +1,31 @@
+package textee
+
+import "testing"
+
+func TestTextee_WeightedSubstrings(t *testing.T) {
+	tt, err := NewTextee("the cat sat. the dog sat. the cat ran.")
+	if err != nil {
+		t.Fatalf("NewTextee() error = %v", err)
+	}
+
+	sorted := tt.SortedSubstrings()
+	unweighted := tt.WeightedSubstrings(nil)
+	if len(unweighted) != len(sorted) {
+		t.Fatalf("WeightedSubstrings(nil) returned %d substrings, want %d", len(unweighted), len(sorted))
+	}
+	for i := range sorted {
+		if unweighted[i].Substring != sorted[i].Substring || unweighted[i].Score != float64(sorted[i].Quantity) {
+			t.Fatalf("WeightedSubstrings(nil)[%d] = %v, want the SortedSubstrings order %v", i, unweighted[i], sorted[i])
+		}
+	}
+
+	weighted := tt.WeightedSubstrings(map[int]float64{1: 0.5, 3: 4})
+	if first := weighted[0]; first.Substring != "the cat ran" || first.Quantity != 1 || first.Score != 4 {
+		t.Errorf("WeightedSubstrings()[0] = %v, want the cat ran scoring 4", first)
+	}
+	for _, w := range weighted {
+		if w.Substring == "the" && w.Score != 1.5 {
+			t.Errorf("WeightedSubstrings() the = %v, want 1.5", w.Score)
+		}
+	}
+}